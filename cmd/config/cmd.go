@@ -17,6 +17,7 @@ func Command() *cobra.Command {
 	configCmd.AddCommand(
 		initCommand(),
 		setCommand(),
+		validateCommand(),
 	)
 	return configCmd
 }
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/nakamasato/aicoder/config"
+	"github.com/nakamasato/aicoder/internal/prompt"
+	"github.com/spf13/cobra"
+
+	// register the prompt templates and the examples
+	_ "github.com/nakamasato/aicoder/internal/locator"
+	_ "github.com/nakamasato/aicoder/internal/planner"
+	_ "github.com/nakamasato/aicoder/internal/repairer"
+	_ "github.com/nakamasato/aicoder/internal/summarizer"
+)
+
+func validateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the prompt templates and the examples in the prompts directory",
+		Long:  "Validate the files in prompts.dir of the config: every template must be executable with the data passed by aicoder and every examples file must match the type of the built-in examples.",
+		Args:  cobra.NoArgs,
+		Run:   runValidate,
+	}
+}
+
+func runValidate(cmd *cobra.Command, args []string) {
+	cfg := config.GetConfig()
+	if cfg.Prompts.Dir == "" {
+		fmt.Println("prompts.dir is not set. The built-in prompts are used.")
+		return
+	}
+	if err := prompt.NewLoader(cfg.Prompts.Dir).Validate(); err != nil {
+		log.Fatalf("invalid prompts in %s:\n%v", cfg.Prompts.Dir, err)
+	}
+	fmt.Printf("prompts in %s are valid\n", cfg.Prompts.Dir)
+}
@@ -18,6 +18,11 @@ func Command() *cobra.Command {
 	dbCmd.AddCommand(
 		migrateCommand(),
 		resetCommand(),
+		exportCommand(),
+		importCommand(),
+		statsCommand(),
+		docsCommand(),
+		pruneCommand(),
 	)
 	return dbCmd
 }
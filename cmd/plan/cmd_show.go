@@ -0,0 +1,51 @@
+package plan
+
+import (
+	"io"
+	"log"
+	"os"
+
+	"github.com/nakamasato/aicoder/internal/planner"
+	"github.com/nakamasato/aicoder/internal/renderer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	showPlanFile string
+	showOutput   string
+	showFormat   = renderer.FormatTerminal
+)
+
+func showCommand() *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show the plan with the diff of each change.",
+		Long:  "Render the goal, the steps, the investigation results and the diff of each change against the current files. Use --format=markdown to paste the plan into a pull request description.",
+		Args:  cobra.NoArgs,
+		Run:   runShow,
+	}
+	showCmd.Flags().StringVarP(&showPlanFile, "planfile", "p", "plan.json", "Path to the plan file to show")
+	showCmd.Flags().VarP(&showFormat, "format", "f", "Output format (terminal or markdown)")
+	showCmd.Flags().StringVarP(&showOutput, "output", "o", "", "Optional file to write the rendered plan to")
+	return showCmd
+}
+
+func runShow(cmd *cobra.Command, args []string) {
+	changesPlan, err := planner.LoadPlanFile[planner.ChangesPlan](showPlanFile)
+	if err != nil {
+		log.Fatalf("failed to read plan file: %v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if showOutput != "" {
+		f, err := os.Create(showOutput)
+		if err != nil {
+			log.Fatalf("failed to create output file: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := renderer.RenderPlan(w, changesPlan, showFormat); err != nil {
+		log.Fatalf("failed to render plan: %v", err)
+	}
+}
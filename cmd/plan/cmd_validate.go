@@ -0,0 +1,42 @@
+package plan
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/nakamasato/aicoder/internal/planner"
+	"github.com/spf13/cobra"
+)
+
+var validatePlanFile string
+
+func validateCommand() *cobra.Command {
+	validateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the plan against the current files without applying it.",
+		Long:  "Resolve every block of the plan against the current files, parse the new contents and report stale targets, conflicts and invalid contents.",
+		Args:  cobra.NoArgs,
+		Run:   runValidate,
+	}
+	validateCmd.Flags().StringVarP(&validatePlanFile, "planfile", "p", "plan.json", "Path to the plan file to validate")
+	return validateCmd
+}
+
+func runValidate(cmd *cobra.Command, args []string) {
+	changesPlan, err := planner.LoadPlanFile[planner.ChangesPlan](validatePlanFile)
+	if err != nil {
+		log.Fatalf("failed to read plan file: %v", err)
+	}
+
+	issues := planner.ValidatePlan(changesPlan)
+	if len(issues) == 0 {
+		fmt.Printf("%s is valid (%d changes)\n", validatePlanFile, len(changesPlan.Changes))
+		return
+	}
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+	fmt.Printf("%s has %d issues\n", validatePlanFile, len(issues))
+	os.Exit(1)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/nakamasato/aicoder/cmd/db"
 	"github.com/nakamasato/aicoder/cmd/debug"
 	"github.com/nakamasato/aicoder/cmd/gh"
+	"github.com/nakamasato/aicoder/cmd/history"
 	"github.com/nakamasato/aicoder/cmd/load"
 	"github.com/nakamasato/aicoder/cmd/plan"
 	"github.com/nakamasato/aicoder/cmd/review"
@@ -44,6 +45,7 @@ func NewRootCmd() *cobra.Command {
 		db.Command(),
 		debug.Command(),
 		gh.NewGhCmd(),
+		history.Command(),
 		load.Command(),
 		plan.Command(),
 		review.Command(),
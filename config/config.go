@@ -18,6 +18,8 @@ type AICoderConfig struct {
 	Contexts       map[string]LoadConfig `mapstructure:"contexts"`        // Contexts for different LoadConfigs
 	CurrentContext string                `mapstructure:"current_context"` // Current context to use
 	Search         SearchConfig          `mapstructure:"search"`
+	Prompts        PromptsConfig         `mapstructure:"prompts"`
+	Conventions    string                `mapstructure:"conventions"` // Coding conventions of the repository. AICODER.md is used if empty.
 	OpenAIAPIKey   string                `mapstructure:"openai_api_key"`
 }
 
@@ -30,6 +32,28 @@ func (c *AICoderConfig) GetCurrentLoadConfig() LoadConfig {
 	return LoadConfig{} // This line will never be reached due to log.Fatalf
 }
 
+// ConventionsFile is the file in the working directory that describes the conventions of the repository.
+const ConventionsFile = "AICODER.md"
+
+// GetConventions returns the coding conventions of the repository that the planned changes must follow.
+// The conventions key in the config takes precedence over ConventionsFile. Empty if neither exists.
+func (c *AICoderConfig) GetConventions() (string, error) {
+	if c.Conventions != "" {
+		return c.Conventions, nil
+	}
+	cwd, err := getWorkingDirectory()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current dir: %w", err)
+	}
+	data, err := os.ReadFile(filepath.Join(cwd, ConventionsFile))
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", ConventionsFile, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
 type LoadConfig struct {
 	TargetPath string   `mapstructure:"target_path"` // Target path to load files from
 	Exclude    []string `mapstructure:"exclude"`     // List of paths to exclude
@@ -63,6 +87,11 @@ type SearchConfig struct {
 	TopN int `mapstructure:"top_n"`
 }
 
+// PromptsConfig configures the overrides of the prompt templates and the few-shot examples.
+type PromptsConfig struct {
+	Dir string `mapstructure:"dir"` // Directory of the prompt templates (<name>.tmpl) and the examples (examples/<name>.json)
+}
+
 // cfg holds the loaded configuration.
 var cfg AICoderConfig
 
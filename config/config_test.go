@@ -3,6 +3,8 @@ package config
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -60,3 +62,31 @@ func TestDefaultConfig(t *testing.T) {
 	assert.Contains(t, string(defaultConfig), "repository: aicoder")
 	assert.Contains(t, string(defaultConfig), "current_context: default")
 }
+
+func TestGetConventions(t *testing.T) {
+	dir := t.TempDir()
+	getWorkingDirectory = func() (string, error) {
+		return dir, nil
+	}
+	defer func() { getWorkingDirectory = os.Getwd }()
+
+	// no conventions
+	c := AICoderConfig{}
+	conventions, err := c.GetConventions()
+	assert.NoError(t, err)
+	assert.Equal(t, "", conventions)
+
+	// AICODER.md
+	if err := os.WriteFile(filepath.Join(dir, ConventionsFile), []byte("- Use testify in tests.\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	conventions, err = c.GetConventions()
+	assert.NoError(t, err)
+	assert.Equal(t, "- Use testify in tests.", conventions)
+
+	// the config takes precedence over AICODER.md
+	c.Conventions = "- Wrap errors with %w."
+	conventions, err = c.GetConventions()
+	assert.NoError(t, err)
+	assert.Equal(t, "- Wrap errors with %w.", conventions)
+}
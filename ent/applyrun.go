@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/plan"
+)
+
+// ApplyRun is the model entity for the ApplyRun schema.
+type ApplyRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID string `json:"plan_id,omitempty"`
+	// Dryrun holds the value of the "dryrun" field.
+	Dryrun bool `json:"dryrun,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes int `json:"changes,omitempty"`
+	// Succeeded holds the value of the "succeeded" field.
+	Succeeded bool `json:"succeeded,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ApplyRunQuery when eager-loading is set.
+	Edges        ApplyRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ApplyRunEdges holds the relations/edges for other nodes in the graph.
+type ApplyRunEdges struct {
+	// Plan holds the value of the plan edge.
+	Plan *Plan `json:"plan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ApplyRunEdges) PlanOrErr() (*Plan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: plan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ApplyRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case applyrun.FieldDryrun, applyrun.FieldSucceeded:
+			values[i] = new(sql.NullBool)
+		case applyrun.FieldID, applyrun.FieldChanges:
+			values[i] = new(sql.NullInt64)
+		case applyrun.FieldPlanID, applyrun.FieldError:
+			values[i] = new(sql.NullString)
+		case applyrun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ApplyRun fields.
+func (ar *ApplyRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case applyrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ar.ID = int(value.Int64)
+		case applyrun.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				ar.PlanID = value.String
+			}
+		case applyrun.FieldDryrun:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dryrun", values[i])
+			} else if value.Valid {
+				ar.Dryrun = value.Bool
+			}
+		case applyrun.FieldChanges:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value.Valid {
+				ar.Changes = int(value.Int64)
+			}
+		case applyrun.FieldSucceeded:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field succeeded", values[i])
+			} else if value.Valid {
+				ar.Succeeded = value.Bool
+			}
+		case applyrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ar.Error = value.String
+			}
+		case applyrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ApplyRun.
+// This includes values selected through modifiers, order, etc.
+func (ar *ApplyRun) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// QueryPlan queries the "plan" edge of the ApplyRun entity.
+func (ar *ApplyRun) QueryPlan() *PlanQuery {
+	return NewApplyRunClient(ar.config).QueryPlan(ar)
+}
+
+// Update returns a builder for updating this ApplyRun.
+// Note that you need to call ApplyRun.Unwrap() before calling this method if this ApplyRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *ApplyRun) Update() *ApplyRunUpdateOne {
+	return NewApplyRunClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the ApplyRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *ApplyRun) Unwrap() *ApplyRun {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ApplyRun is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *ApplyRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ApplyRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("plan_id=")
+	builder.WriteString(ar.PlanID)
+	builder.WriteString(", ")
+	builder.WriteString("dryrun=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Dryrun))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("succeeded=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Succeeded))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ar.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ApplyRuns is a parsable slice of ApplyRun.
+type ApplyRuns []*ApplyRun
@@ -0,0 +1,764 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/plan"
+)
+
+// ApplyRunCreate is the builder for creating a ApplyRun entity.
+type ApplyRunCreate struct {
+	config
+	mutation *ApplyRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlanID sets the "plan_id" field.
+func (arc *ApplyRunCreate) SetPlanID(s string) *ApplyRunCreate {
+	arc.mutation.SetPlanID(s)
+	return arc
+}
+
+// SetDryrun sets the "dryrun" field.
+func (arc *ApplyRunCreate) SetDryrun(b bool) *ApplyRunCreate {
+	arc.mutation.SetDryrun(b)
+	return arc
+}
+
+// SetChanges sets the "changes" field.
+func (arc *ApplyRunCreate) SetChanges(i int) *ApplyRunCreate {
+	arc.mutation.SetChanges(i)
+	return arc
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (arc *ApplyRunCreate) SetSucceeded(b bool) *ApplyRunCreate {
+	arc.mutation.SetSucceeded(b)
+	return arc
+}
+
+// SetError sets the "error" field.
+func (arc *ApplyRunCreate) SetError(s string) *ApplyRunCreate {
+	arc.mutation.SetError(s)
+	return arc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (arc *ApplyRunCreate) SetNillableError(s *string) *ApplyRunCreate {
+	if s != nil {
+		arc.SetError(*s)
+	}
+	return arc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *ApplyRunCreate) SetCreatedAt(t time.Time) *ApplyRunCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *ApplyRunCreate) SetNillableCreatedAt(t *time.Time) *ApplyRunCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (arc *ApplyRunCreate) SetPlan(p *Plan) *ApplyRunCreate {
+	return arc.SetPlanID(p.ID)
+}
+
+// Mutation returns the ApplyRunMutation object of the builder.
+func (arc *ApplyRunCreate) Mutation() *ApplyRunMutation {
+	return arc.mutation
+}
+
+// Save creates the ApplyRun in the database.
+func (arc *ApplyRunCreate) Save(ctx context.Context) (*ApplyRun, error) {
+	arc.defaults()
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *ApplyRunCreate) SaveX(ctx context.Context) *ApplyRun {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *ApplyRunCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *ApplyRunCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *ApplyRunCreate) defaults() {
+	if _, ok := arc.mutation.Error(); !ok {
+		v := applyrun.DefaultError
+		arc.mutation.SetError(v)
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := applyrun.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *ApplyRunCreate) check() error {
+	if _, ok := arc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "ApplyRun.plan_id"`)}
+	}
+	if _, ok := arc.mutation.Dryrun(); !ok {
+		return &ValidationError{Name: "dryrun", err: errors.New(`ent: missing required field "ApplyRun.dryrun"`)}
+	}
+	if _, ok := arc.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "ApplyRun.changes"`)}
+	}
+	if _, ok := arc.mutation.Succeeded(); !ok {
+		return &ValidationError{Name: "succeeded", err: errors.New(`ent: missing required field "ApplyRun.succeeded"`)}
+	}
+	if _, ok := arc.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "ApplyRun.error"`)}
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ApplyRun.created_at"`)}
+	}
+	if _, ok := arc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan", err: errors.New(`ent: missing required edge "ApplyRun.plan"`)}
+	}
+	return nil
+}
+
+func (arc *ApplyRunCreate) sqlSave(ctx context.Context) (*ApplyRun, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *ApplyRunCreate) createSpec() (*ApplyRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApplyRun{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(applyrun.Table, sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = arc.conflict
+	if value, ok := arc.mutation.Dryrun(); ok {
+		_spec.SetField(applyrun.FieldDryrun, field.TypeBool, value)
+		_node.Dryrun = value
+	}
+	if value, ok := arc.mutation.Changes(); ok {
+		_spec.SetField(applyrun.FieldChanges, field.TypeInt, value)
+		_node.Changes = value
+	}
+	if value, ok := arc.mutation.Succeeded(); ok {
+		_spec.SetField(applyrun.FieldSucceeded, field.TypeBool, value)
+		_node.Succeeded = value
+	}
+	if value, ok := arc.mutation.Error(); ok {
+		_spec.SetField(applyrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(applyrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := arc.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applyrun.PlanTable,
+			Columns: []string{applyrun.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ApplyRun.Create().
+//		SetPlanID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ApplyRunUpsert) {
+//			SetPlanID(v+v).
+//		}).
+//		Exec(ctx)
+func (arc *ApplyRunCreate) OnConflict(opts ...sql.ConflictOption) *ApplyRunUpsertOne {
+	arc.conflict = opts
+	return &ApplyRunUpsertOne{
+		create: arc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ApplyRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arc *ApplyRunCreate) OnConflictColumns(columns ...string) *ApplyRunUpsertOne {
+	arc.conflict = append(arc.conflict, sql.ConflictColumns(columns...))
+	return &ApplyRunUpsertOne{
+		create: arc,
+	}
+}
+
+type (
+	// ApplyRunUpsertOne is the builder for "upsert"-ing
+	//  one ApplyRun node.
+	ApplyRunUpsertOne struct {
+		create *ApplyRunCreate
+	}
+
+	// ApplyRunUpsert is the "OnConflict" setter.
+	ApplyRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlanID sets the "plan_id" field.
+func (u *ApplyRunUpsert) SetPlanID(v string) *ApplyRunUpsert {
+	u.Set(applyrun.FieldPlanID, v)
+	return u
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *ApplyRunUpsert) UpdatePlanID() *ApplyRunUpsert {
+	u.SetExcluded(applyrun.FieldPlanID)
+	return u
+}
+
+// SetDryrun sets the "dryrun" field.
+func (u *ApplyRunUpsert) SetDryrun(v bool) *ApplyRunUpsert {
+	u.Set(applyrun.FieldDryrun, v)
+	return u
+}
+
+// UpdateDryrun sets the "dryrun" field to the value that was provided on create.
+func (u *ApplyRunUpsert) UpdateDryrun() *ApplyRunUpsert {
+	u.SetExcluded(applyrun.FieldDryrun)
+	return u
+}
+
+// SetChanges sets the "changes" field.
+func (u *ApplyRunUpsert) SetChanges(v int) *ApplyRunUpsert {
+	u.Set(applyrun.FieldChanges, v)
+	return u
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ApplyRunUpsert) UpdateChanges() *ApplyRunUpsert {
+	u.SetExcluded(applyrun.FieldChanges)
+	return u
+}
+
+// AddChanges adds v to the "changes" field.
+func (u *ApplyRunUpsert) AddChanges(v int) *ApplyRunUpsert {
+	u.Add(applyrun.FieldChanges, v)
+	return u
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (u *ApplyRunUpsert) SetSucceeded(v bool) *ApplyRunUpsert {
+	u.Set(applyrun.FieldSucceeded, v)
+	return u
+}
+
+// UpdateSucceeded sets the "succeeded" field to the value that was provided on create.
+func (u *ApplyRunUpsert) UpdateSucceeded() *ApplyRunUpsert {
+	u.SetExcluded(applyrun.FieldSucceeded)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ApplyRunUpsert) SetError(v string) *ApplyRunUpsert {
+	u.Set(applyrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ApplyRunUpsert) UpdateError() *ApplyRunUpsert {
+	u.SetExcluded(applyrun.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ApplyRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ApplyRunUpsertOne) UpdateNewValues() *ApplyRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(applyrun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ApplyRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ApplyRunUpsertOne) Ignore() *ApplyRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ApplyRunUpsertOne) DoNothing() *ApplyRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ApplyRunCreate.OnConflict
+// documentation for more info.
+func (u *ApplyRunUpsertOne) Update(set func(*ApplyRunUpsert)) *ApplyRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ApplyRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *ApplyRunUpsertOne) SetPlanID(v string) *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *ApplyRunUpsertOne) UpdatePlanID() *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// SetDryrun sets the "dryrun" field.
+func (u *ApplyRunUpsertOne) SetDryrun(v bool) *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetDryrun(v)
+	})
+}
+
+// UpdateDryrun sets the "dryrun" field to the value that was provided on create.
+func (u *ApplyRunUpsertOne) UpdateDryrun() *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdateDryrun()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *ApplyRunUpsertOne) SetChanges(v int) *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// AddChanges adds v to the "changes" field.
+func (u *ApplyRunUpsertOne) AddChanges(v int) *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.AddChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ApplyRunUpsertOne) UpdateChanges() *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (u *ApplyRunUpsertOne) SetSucceeded(v bool) *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetSucceeded(v)
+	})
+}
+
+// UpdateSucceeded sets the "succeeded" field to the value that was provided on create.
+func (u *ApplyRunUpsertOne) UpdateSucceeded() *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdateSucceeded()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ApplyRunUpsertOne) SetError(v string) *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ApplyRunUpsertOne) UpdateError() *ApplyRunUpsertOne {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// Exec executes the query.
+func (u *ApplyRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ApplyRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ApplyRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ApplyRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ApplyRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ApplyRunCreateBulk is the builder for creating many ApplyRun entities in bulk.
+type ApplyRunCreateBulk struct {
+	config
+	err      error
+	builders []*ApplyRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ApplyRun entities in the database.
+func (arcb *ApplyRunCreateBulk) Save(ctx context.Context) ([]*ApplyRun, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*ApplyRun, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApplyRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = arcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *ApplyRunCreateBulk) SaveX(ctx context.Context) []*ApplyRun {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *ApplyRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *ApplyRunCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ApplyRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ApplyRunUpsert) {
+//			SetPlanID(v+v).
+//		}).
+//		Exec(ctx)
+func (arcb *ApplyRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ApplyRunUpsertBulk {
+	arcb.conflict = opts
+	return &ApplyRunUpsertBulk{
+		create: arcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ApplyRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arcb *ApplyRunCreateBulk) OnConflictColumns(columns ...string) *ApplyRunUpsertBulk {
+	arcb.conflict = append(arcb.conflict, sql.ConflictColumns(columns...))
+	return &ApplyRunUpsertBulk{
+		create: arcb,
+	}
+}
+
+// ApplyRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ApplyRun nodes.
+type ApplyRunUpsertBulk struct {
+	create *ApplyRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ApplyRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ApplyRunUpsertBulk) UpdateNewValues() *ApplyRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(applyrun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ApplyRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ApplyRunUpsertBulk) Ignore() *ApplyRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ApplyRunUpsertBulk) DoNothing() *ApplyRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ApplyRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ApplyRunUpsertBulk) Update(set func(*ApplyRunUpsert)) *ApplyRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ApplyRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *ApplyRunUpsertBulk) SetPlanID(v string) *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *ApplyRunUpsertBulk) UpdatePlanID() *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// SetDryrun sets the "dryrun" field.
+func (u *ApplyRunUpsertBulk) SetDryrun(v bool) *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetDryrun(v)
+	})
+}
+
+// UpdateDryrun sets the "dryrun" field to the value that was provided on create.
+func (u *ApplyRunUpsertBulk) UpdateDryrun() *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdateDryrun()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *ApplyRunUpsertBulk) SetChanges(v int) *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// AddChanges adds v to the "changes" field.
+func (u *ApplyRunUpsertBulk) AddChanges(v int) *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.AddChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ApplyRunUpsertBulk) UpdateChanges() *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (u *ApplyRunUpsertBulk) SetSucceeded(v bool) *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetSucceeded(v)
+	})
+}
+
+// UpdateSucceeded sets the "succeeded" field to the value that was provided on create.
+func (u *ApplyRunUpsertBulk) UpdateSucceeded() *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdateSucceeded()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ApplyRunUpsertBulk) SetError(v string) *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ApplyRunUpsertBulk) UpdateError() *ApplyRunUpsertBulk {
+	return u.Update(func(s *ApplyRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// Exec executes the query.
+func (u *ApplyRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ApplyRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ApplyRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ApplyRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
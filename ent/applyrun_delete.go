@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/predicate"
+)
+
+// ApplyRunDelete is the builder for deleting a ApplyRun entity.
+type ApplyRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *ApplyRunMutation
+}
+
+// Where appends a list predicates to the ApplyRunDelete builder.
+func (ard *ApplyRunDelete) Where(ps ...predicate.ApplyRun) *ApplyRunDelete {
+	ard.mutation.Where(ps...)
+	return ard
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ard *ApplyRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ard.sqlExec, ard.mutation, ard.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ard *ApplyRunDelete) ExecX(ctx context.Context) int {
+	n, err := ard.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ard *ApplyRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(applyrun.Table, sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt))
+	if ps := ard.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ard.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ard.mutation.done = true
+	return affected, err
+}
+
+// ApplyRunDeleteOne is the builder for deleting a single ApplyRun entity.
+type ApplyRunDeleteOne struct {
+	ard *ApplyRunDelete
+}
+
+// Where appends a list predicates to the ApplyRunDelete builder.
+func (ardo *ApplyRunDeleteOne) Where(ps ...predicate.ApplyRun) *ApplyRunDeleteOne {
+	ardo.ard.mutation.Where(ps...)
+	return ardo
+}
+
+// Exec executes the deletion query.
+func (ardo *ApplyRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := ardo.ard.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{applyrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ardo *ApplyRunDeleteOne) ExecX(ctx context.Context) {
+	if err := ardo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,462 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/predicate"
+)
+
+// ApplyRunUpdate is the builder for updating ApplyRun entities.
+type ApplyRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ApplyRunMutation
+}
+
+// Where appends a list predicates to the ApplyRunUpdate builder.
+func (aru *ApplyRunUpdate) Where(ps ...predicate.ApplyRun) *ApplyRunUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// SetPlanID sets the "plan_id" field.
+func (aru *ApplyRunUpdate) SetPlanID(s string) *ApplyRunUpdate {
+	aru.mutation.SetPlanID(s)
+	return aru
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (aru *ApplyRunUpdate) SetNillablePlanID(s *string) *ApplyRunUpdate {
+	if s != nil {
+		aru.SetPlanID(*s)
+	}
+	return aru
+}
+
+// SetDryrun sets the "dryrun" field.
+func (aru *ApplyRunUpdate) SetDryrun(b bool) *ApplyRunUpdate {
+	aru.mutation.SetDryrun(b)
+	return aru
+}
+
+// SetNillableDryrun sets the "dryrun" field if the given value is not nil.
+func (aru *ApplyRunUpdate) SetNillableDryrun(b *bool) *ApplyRunUpdate {
+	if b != nil {
+		aru.SetDryrun(*b)
+	}
+	return aru
+}
+
+// SetChanges sets the "changes" field.
+func (aru *ApplyRunUpdate) SetChanges(i int) *ApplyRunUpdate {
+	aru.mutation.ResetChanges()
+	aru.mutation.SetChanges(i)
+	return aru
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (aru *ApplyRunUpdate) SetNillableChanges(i *int) *ApplyRunUpdate {
+	if i != nil {
+		aru.SetChanges(*i)
+	}
+	return aru
+}
+
+// AddChanges adds i to the "changes" field.
+func (aru *ApplyRunUpdate) AddChanges(i int) *ApplyRunUpdate {
+	aru.mutation.AddChanges(i)
+	return aru
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (aru *ApplyRunUpdate) SetSucceeded(b bool) *ApplyRunUpdate {
+	aru.mutation.SetSucceeded(b)
+	return aru
+}
+
+// SetNillableSucceeded sets the "succeeded" field if the given value is not nil.
+func (aru *ApplyRunUpdate) SetNillableSucceeded(b *bool) *ApplyRunUpdate {
+	if b != nil {
+		aru.SetSucceeded(*b)
+	}
+	return aru
+}
+
+// SetError sets the "error" field.
+func (aru *ApplyRunUpdate) SetError(s string) *ApplyRunUpdate {
+	aru.mutation.SetError(s)
+	return aru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aru *ApplyRunUpdate) SetNillableError(s *string) *ApplyRunUpdate {
+	if s != nil {
+		aru.SetError(*s)
+	}
+	return aru
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (aru *ApplyRunUpdate) SetPlan(p *Plan) *ApplyRunUpdate {
+	return aru.SetPlanID(p.ID)
+}
+
+// Mutation returns the ApplyRunMutation object of the builder.
+func (aru *ApplyRunUpdate) Mutation() *ApplyRunMutation {
+	return aru.mutation
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (aru *ApplyRunUpdate) ClearPlan() *ApplyRunUpdate {
+	aru.mutation.ClearPlan()
+	return aru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *ApplyRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *ApplyRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *ApplyRunUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *ApplyRunUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aru *ApplyRunUpdate) check() error {
+	if _, ok := aru.mutation.PlanID(); aru.mutation.PlanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ApplyRun.plan"`)
+	}
+	return nil
+}
+
+func (aru *ApplyRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(applyrun.Table, applyrun.Columns, sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aru.mutation.Dryrun(); ok {
+		_spec.SetField(applyrun.FieldDryrun, field.TypeBool, value)
+	}
+	if value, ok := aru.mutation.Changes(); ok {
+		_spec.SetField(applyrun.FieldChanges, field.TypeInt, value)
+	}
+	if value, ok := aru.mutation.AddedChanges(); ok {
+		_spec.AddField(applyrun.FieldChanges, field.TypeInt, value)
+	}
+	if value, ok := aru.mutation.Succeeded(); ok {
+		_spec.SetField(applyrun.FieldSucceeded, field.TypeBool, value)
+	}
+	if value, ok := aru.mutation.Error(); ok {
+		_spec.SetField(applyrun.FieldError, field.TypeString, value)
+	}
+	if aru.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applyrun.PlanTable,
+			Columns: []string{applyrun.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aru.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applyrun.PlanTable,
+			Columns: []string{applyrun.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{applyrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// ApplyRunUpdateOne is the builder for updating a single ApplyRun entity.
+type ApplyRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ApplyRunMutation
+}
+
+// SetPlanID sets the "plan_id" field.
+func (aruo *ApplyRunUpdateOne) SetPlanID(s string) *ApplyRunUpdateOne {
+	aruo.mutation.SetPlanID(s)
+	return aruo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (aruo *ApplyRunUpdateOne) SetNillablePlanID(s *string) *ApplyRunUpdateOne {
+	if s != nil {
+		aruo.SetPlanID(*s)
+	}
+	return aruo
+}
+
+// SetDryrun sets the "dryrun" field.
+func (aruo *ApplyRunUpdateOne) SetDryrun(b bool) *ApplyRunUpdateOne {
+	aruo.mutation.SetDryrun(b)
+	return aruo
+}
+
+// SetNillableDryrun sets the "dryrun" field if the given value is not nil.
+func (aruo *ApplyRunUpdateOne) SetNillableDryrun(b *bool) *ApplyRunUpdateOne {
+	if b != nil {
+		aruo.SetDryrun(*b)
+	}
+	return aruo
+}
+
+// SetChanges sets the "changes" field.
+func (aruo *ApplyRunUpdateOne) SetChanges(i int) *ApplyRunUpdateOne {
+	aruo.mutation.ResetChanges()
+	aruo.mutation.SetChanges(i)
+	return aruo
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (aruo *ApplyRunUpdateOne) SetNillableChanges(i *int) *ApplyRunUpdateOne {
+	if i != nil {
+		aruo.SetChanges(*i)
+	}
+	return aruo
+}
+
+// AddChanges adds i to the "changes" field.
+func (aruo *ApplyRunUpdateOne) AddChanges(i int) *ApplyRunUpdateOne {
+	aruo.mutation.AddChanges(i)
+	return aruo
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (aruo *ApplyRunUpdateOne) SetSucceeded(b bool) *ApplyRunUpdateOne {
+	aruo.mutation.SetSucceeded(b)
+	return aruo
+}
+
+// SetNillableSucceeded sets the "succeeded" field if the given value is not nil.
+func (aruo *ApplyRunUpdateOne) SetNillableSucceeded(b *bool) *ApplyRunUpdateOne {
+	if b != nil {
+		aruo.SetSucceeded(*b)
+	}
+	return aruo
+}
+
+// SetError sets the "error" field.
+func (aruo *ApplyRunUpdateOne) SetError(s string) *ApplyRunUpdateOne {
+	aruo.mutation.SetError(s)
+	return aruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aruo *ApplyRunUpdateOne) SetNillableError(s *string) *ApplyRunUpdateOne {
+	if s != nil {
+		aruo.SetError(*s)
+	}
+	return aruo
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (aruo *ApplyRunUpdateOne) SetPlan(p *Plan) *ApplyRunUpdateOne {
+	return aruo.SetPlanID(p.ID)
+}
+
+// Mutation returns the ApplyRunMutation object of the builder.
+func (aruo *ApplyRunUpdateOne) Mutation() *ApplyRunMutation {
+	return aruo.mutation
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (aruo *ApplyRunUpdateOne) ClearPlan() *ApplyRunUpdateOne {
+	aruo.mutation.ClearPlan()
+	return aruo
+}
+
+// Where appends a list predicates to the ApplyRunUpdate builder.
+func (aruo *ApplyRunUpdateOne) Where(ps ...predicate.ApplyRun) *ApplyRunUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *ApplyRunUpdateOne) Select(field string, fields ...string) *ApplyRunUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated ApplyRun entity.
+func (aruo *ApplyRunUpdateOne) Save(ctx context.Context) (*ApplyRun, error) {
+	return withHooks(ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *ApplyRunUpdateOne) SaveX(ctx context.Context) *ApplyRun {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *ApplyRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *ApplyRunUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aruo *ApplyRunUpdateOne) check() error {
+	if _, ok := aruo.mutation.PlanID(); aruo.mutation.PlanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ApplyRun.plan"`)
+	}
+	return nil
+}
+
+func (aruo *ApplyRunUpdateOne) sqlSave(ctx context.Context) (_node *ApplyRun, err error) {
+	if err := aruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(applyrun.Table, applyrun.Columns, sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ApplyRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, applyrun.FieldID)
+		for _, f := range fields {
+			if !applyrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != applyrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aruo.mutation.Dryrun(); ok {
+		_spec.SetField(applyrun.FieldDryrun, field.TypeBool, value)
+	}
+	if value, ok := aruo.mutation.Changes(); ok {
+		_spec.SetField(applyrun.FieldChanges, field.TypeInt, value)
+	}
+	if value, ok := aruo.mutation.AddedChanges(); ok {
+		_spec.AddField(applyrun.FieldChanges, field.TypeInt, value)
+	}
+	if value, ok := aruo.mutation.Succeeded(); ok {
+		_spec.SetField(applyrun.FieldSucceeded, field.TypeBool, value)
+	}
+	if value, ok := aruo.mutation.Error(); ok {
+		_spec.SetField(applyrun.FieldError, field.TypeString, value)
+	}
+	if aruo.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applyrun.PlanTable,
+			Columns: []string{applyrun.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aruo.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   applyrun.PlanTable,
+			Columns: []string{applyrun.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ApplyRun{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{applyrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/plan"
+)
+
+// BlockChange is the model entity for the BlockChange schema.
+type BlockChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID string `json:"plan_id,omitempty"`
+	// Index holds the value of the "index" field.
+	Index int `json:"index,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// TargetType holds the value of the "target_type" field.
+	TargetType string `json:"target_type,omitempty"`
+	// TargetName holds the value of the "target_name" field.
+	TargetName string `json:"target_name,omitempty"`
+	// NewContent holds the value of the "new_content" field.
+	NewContent string `json:"new_content,omitempty"`
+	// NewComment holds the value of the "new_comment" field.
+	NewComment string `json:"new_comment,omitempty"`
+	// Decision holds the value of the "decision" field.
+	Decision string `json:"decision,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlockChangeQuery when eager-loading is set.
+	Edges        BlockChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BlockChangeEdges holds the relations/edges for other nodes in the graph.
+type BlockChangeEdges struct {
+	// Plan holds the value of the plan edge.
+	Plan *Plan `json:"plan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockChangeEdges) PlanOrErr() (*Plan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: plan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlockChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blockchange.FieldID, blockchange.FieldIndex:
+			values[i] = new(sql.NullInt64)
+		case blockchange.FieldPlanID, blockchange.FieldAction, blockchange.FieldPath, blockchange.FieldTargetType, blockchange.FieldTargetName, blockchange.FieldNewContent, blockchange.FieldNewComment, blockchange.FieldDecision:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlockChange fields.
+func (bc *BlockChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blockchange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bc.ID = int(value.Int64)
+		case blockchange.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				bc.PlanID = value.String
+			}
+		case blockchange.FieldIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field index", values[i])
+			} else if value.Valid {
+				bc.Index = int(value.Int64)
+			}
+		case blockchange.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				bc.Action = value.String
+			}
+		case blockchange.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				bc.Path = value.String
+			}
+		case blockchange.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				bc.TargetType = value.String
+			}
+		case blockchange.FieldTargetName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_name", values[i])
+			} else if value.Valid {
+				bc.TargetName = value.String
+			}
+		case blockchange.FieldNewContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_content", values[i])
+			} else if value.Valid {
+				bc.NewContent = value.String
+			}
+		case blockchange.FieldNewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_comment", values[i])
+			} else if value.Valid {
+				bc.NewComment = value.String
+			}
+		case blockchange.FieldDecision:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decision", values[i])
+			} else if value.Valid {
+				bc.Decision = value.String
+			}
+		default:
+			bc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlockChange.
+// This includes values selected through modifiers, order, etc.
+func (bc *BlockChange) Value(name string) (ent.Value, error) {
+	return bc.selectValues.Get(name)
+}
+
+// QueryPlan queries the "plan" edge of the BlockChange entity.
+func (bc *BlockChange) QueryPlan() *PlanQuery {
+	return NewBlockChangeClient(bc.config).QueryPlan(bc)
+}
+
+// Update returns a builder for updating this BlockChange.
+// Note that you need to call BlockChange.Unwrap() before calling this method if this BlockChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bc *BlockChange) Update() *BlockChangeUpdateOne {
+	return NewBlockChangeClient(bc.config).UpdateOne(bc)
+}
+
+// Unwrap unwraps the BlockChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bc *BlockChange) Unwrap() *BlockChange {
+	_tx, ok := bc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlockChange is not a transactional entity")
+	}
+	bc.config.driver = _tx.drv
+	return bc
+}
+
+// String implements the fmt.Stringer.
+func (bc *BlockChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlockChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bc.ID))
+	builder.WriteString("plan_id=")
+	builder.WriteString(bc.PlanID)
+	builder.WriteString(", ")
+	builder.WriteString("index=")
+	builder.WriteString(fmt.Sprintf("%v", bc.Index))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(bc.Action)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(bc.Path)
+	builder.WriteString(", ")
+	builder.WriteString("target_type=")
+	builder.WriteString(bc.TargetType)
+	builder.WriteString(", ")
+	builder.WriteString("target_name=")
+	builder.WriteString(bc.TargetName)
+	builder.WriteString(", ")
+	builder.WriteString("new_content=")
+	builder.WriteString(bc.NewContent)
+	builder.WriteString(", ")
+	builder.WriteString("new_comment=")
+	builder.WriteString(bc.NewComment)
+	builder.WriteString(", ")
+	builder.WriteString("decision=")
+	builder.WriteString(bc.Decision)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlockChanges is a parsable slice of BlockChange.
+type BlockChanges []*BlockChange
@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blockchange
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the blockchange type in the database.
+	Label = "block_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPlanID holds the string denoting the plan_id field in the database.
+	FieldPlanID = "plan_id"
+	// FieldIndex holds the string denoting the index field in the database.
+	FieldIndex = "index"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldTargetType holds the string denoting the target_type field in the database.
+	FieldTargetType = "target_type"
+	// FieldTargetName holds the string denoting the target_name field in the database.
+	FieldTargetName = "target_name"
+	// FieldNewContent holds the string denoting the new_content field in the database.
+	FieldNewContent = "new_content"
+	// FieldNewComment holds the string denoting the new_comment field in the database.
+	FieldNewComment = "new_comment"
+	// FieldDecision holds the string denoting the decision field in the database.
+	FieldDecision = "decision"
+	// EdgePlan holds the string denoting the plan edge name in mutations.
+	EdgePlan = "plan"
+	// Table holds the table name of the blockchange in the database.
+	Table = "block_changes"
+	// PlanTable is the table that holds the plan relation/edge.
+	PlanTable = "block_changes"
+	// PlanInverseTable is the table name for the Plan entity.
+	// It exists in this package in order to avoid circular dependency with the "plan" package.
+	PlanInverseTable = "plans"
+	// PlanColumn is the table column denoting the plan relation/edge.
+	PlanColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for blockchange fields.
+var Columns = []string{
+	FieldID,
+	FieldPlanID,
+	FieldIndex,
+	FieldAction,
+	FieldPath,
+	FieldTargetType,
+	FieldTargetName,
+	FieldNewContent,
+	FieldNewComment,
+	FieldDecision,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultNewContent holds the default value on creation for the "new_content" field.
+	DefaultNewContent string
+	// DefaultNewComment holds the default value on creation for the "new_comment" field.
+	DefaultNewComment string
+	// DefaultDecision holds the default value on creation for the "decision" field.
+	DefaultDecision string
+)
+
+// OrderOption defines the ordering options for the BlockChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPlanID orders the results by the plan_id field.
+func ByPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanID, opts...).ToFunc()
+}
+
+// ByIndex orders the results by the index field.
+func ByIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIndex, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByTargetType orders the results by the target_type field.
+func ByTargetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetType, opts...).ToFunc()
+}
+
+// ByTargetName orders the results by the target_name field.
+func ByTargetName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetName, opts...).ToFunc()
+}
+
+// ByNewContent orders the results by the new_content field.
+func ByNewContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewContent, opts...).ToFunc()
+}
+
+// ByNewComment orders the results by the new_comment field.
+func ByNewComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewComment, opts...).ToFunc()
+}
+
+// ByDecision orders the results by the decision field.
+func ByDecision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecision, opts...).ToFunc()
+}
+
+// ByPlanField orders the results by plan field.
+func ByPlanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlanStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPlanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+	)
+}
@@ -0,0 +1,697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blockchange
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nakamasato/aicoder/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldID, id))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldPlanID, v))
+}
+
+// Index applies equality check predicate on the "index" field. It's identical to IndexEQ.
+func Index(v int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldIndex, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldAction, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldPath, v))
+}
+
+// TargetType applies equality check predicate on the "target_type" field. It's identical to TargetTypeEQ.
+func TargetType(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetName applies equality check predicate on the "target_name" field. It's identical to TargetNameEQ.
+func TargetName(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldTargetName, v))
+}
+
+// NewContent applies equality check predicate on the "new_content" field. It's identical to NewContentEQ.
+func NewContent(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldNewContent, v))
+}
+
+// NewComment applies equality check predicate on the "new_comment" field. It's identical to NewCommentEQ.
+func NewComment(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldNewComment, v))
+}
+
+// Decision applies equality check predicate on the "decision" field. It's identical to DecisionEQ.
+func Decision(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldDecision, v))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// PlanIDGT applies the GT predicate on the "plan_id" field.
+func PlanIDGT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldPlanID, v))
+}
+
+// PlanIDGTE applies the GTE predicate on the "plan_id" field.
+func PlanIDGTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldPlanID, v))
+}
+
+// PlanIDLT applies the LT predicate on the "plan_id" field.
+func PlanIDLT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldPlanID, v))
+}
+
+// PlanIDLTE applies the LTE predicate on the "plan_id" field.
+func PlanIDLTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldPlanID, v))
+}
+
+// PlanIDContains applies the Contains predicate on the "plan_id" field.
+func PlanIDContains(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContains(FieldPlanID, v))
+}
+
+// PlanIDHasPrefix applies the HasPrefix predicate on the "plan_id" field.
+func PlanIDHasPrefix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasPrefix(FieldPlanID, v))
+}
+
+// PlanIDHasSuffix applies the HasSuffix predicate on the "plan_id" field.
+func PlanIDHasSuffix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasSuffix(FieldPlanID, v))
+}
+
+// PlanIDEqualFold applies the EqualFold predicate on the "plan_id" field.
+func PlanIDEqualFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEqualFold(FieldPlanID, v))
+}
+
+// PlanIDContainsFold applies the ContainsFold predicate on the "plan_id" field.
+func PlanIDContainsFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContainsFold(FieldPlanID, v))
+}
+
+// IndexEQ applies the EQ predicate on the "index" field.
+func IndexEQ(v int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldIndex, v))
+}
+
+// IndexNEQ applies the NEQ predicate on the "index" field.
+func IndexNEQ(v int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldIndex, v))
+}
+
+// IndexIn applies the In predicate on the "index" field.
+func IndexIn(vs ...int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldIndex, vs...))
+}
+
+// IndexNotIn applies the NotIn predicate on the "index" field.
+func IndexNotIn(vs ...int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldIndex, vs...))
+}
+
+// IndexGT applies the GT predicate on the "index" field.
+func IndexGT(v int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldIndex, v))
+}
+
+// IndexGTE applies the GTE predicate on the "index" field.
+func IndexGTE(v int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldIndex, v))
+}
+
+// IndexLT applies the LT predicate on the "index" field.
+func IndexLT(v int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldIndex, v))
+}
+
+// IndexLTE applies the LTE predicate on the "index" field.
+func IndexLTE(v int) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldIndex, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContainsFold(FieldAction, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContainsFold(FieldPath, v))
+}
+
+// TargetTypeEQ applies the EQ predicate on the "target_type" field.
+func TargetTypeEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetTypeNEQ applies the NEQ predicate on the "target_type" field.
+func TargetTypeNEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldTargetType, v))
+}
+
+// TargetTypeIn applies the In predicate on the "target_type" field.
+func TargetTypeIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldTargetType, vs...))
+}
+
+// TargetTypeNotIn applies the NotIn predicate on the "target_type" field.
+func TargetTypeNotIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldTargetType, vs...))
+}
+
+// TargetTypeGT applies the GT predicate on the "target_type" field.
+func TargetTypeGT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldTargetType, v))
+}
+
+// TargetTypeGTE applies the GTE predicate on the "target_type" field.
+func TargetTypeGTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldTargetType, v))
+}
+
+// TargetTypeLT applies the LT predicate on the "target_type" field.
+func TargetTypeLT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldTargetType, v))
+}
+
+// TargetTypeLTE applies the LTE predicate on the "target_type" field.
+func TargetTypeLTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldTargetType, v))
+}
+
+// TargetTypeContains applies the Contains predicate on the "target_type" field.
+func TargetTypeContains(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContains(FieldTargetType, v))
+}
+
+// TargetTypeHasPrefix applies the HasPrefix predicate on the "target_type" field.
+func TargetTypeHasPrefix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasPrefix(FieldTargetType, v))
+}
+
+// TargetTypeHasSuffix applies the HasSuffix predicate on the "target_type" field.
+func TargetTypeHasSuffix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasSuffix(FieldTargetType, v))
+}
+
+// TargetTypeEqualFold applies the EqualFold predicate on the "target_type" field.
+func TargetTypeEqualFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEqualFold(FieldTargetType, v))
+}
+
+// TargetTypeContainsFold applies the ContainsFold predicate on the "target_type" field.
+func TargetTypeContainsFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContainsFold(FieldTargetType, v))
+}
+
+// TargetNameEQ applies the EQ predicate on the "target_name" field.
+func TargetNameEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldTargetName, v))
+}
+
+// TargetNameNEQ applies the NEQ predicate on the "target_name" field.
+func TargetNameNEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldTargetName, v))
+}
+
+// TargetNameIn applies the In predicate on the "target_name" field.
+func TargetNameIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldTargetName, vs...))
+}
+
+// TargetNameNotIn applies the NotIn predicate on the "target_name" field.
+func TargetNameNotIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldTargetName, vs...))
+}
+
+// TargetNameGT applies the GT predicate on the "target_name" field.
+func TargetNameGT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldTargetName, v))
+}
+
+// TargetNameGTE applies the GTE predicate on the "target_name" field.
+func TargetNameGTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldTargetName, v))
+}
+
+// TargetNameLT applies the LT predicate on the "target_name" field.
+func TargetNameLT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldTargetName, v))
+}
+
+// TargetNameLTE applies the LTE predicate on the "target_name" field.
+func TargetNameLTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldTargetName, v))
+}
+
+// TargetNameContains applies the Contains predicate on the "target_name" field.
+func TargetNameContains(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContains(FieldTargetName, v))
+}
+
+// TargetNameHasPrefix applies the HasPrefix predicate on the "target_name" field.
+func TargetNameHasPrefix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasPrefix(FieldTargetName, v))
+}
+
+// TargetNameHasSuffix applies the HasSuffix predicate on the "target_name" field.
+func TargetNameHasSuffix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasSuffix(FieldTargetName, v))
+}
+
+// TargetNameEqualFold applies the EqualFold predicate on the "target_name" field.
+func TargetNameEqualFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEqualFold(FieldTargetName, v))
+}
+
+// TargetNameContainsFold applies the ContainsFold predicate on the "target_name" field.
+func TargetNameContainsFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContainsFold(FieldTargetName, v))
+}
+
+// NewContentEQ applies the EQ predicate on the "new_content" field.
+func NewContentEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldNewContent, v))
+}
+
+// NewContentNEQ applies the NEQ predicate on the "new_content" field.
+func NewContentNEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldNewContent, v))
+}
+
+// NewContentIn applies the In predicate on the "new_content" field.
+func NewContentIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldNewContent, vs...))
+}
+
+// NewContentNotIn applies the NotIn predicate on the "new_content" field.
+func NewContentNotIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldNewContent, vs...))
+}
+
+// NewContentGT applies the GT predicate on the "new_content" field.
+func NewContentGT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldNewContent, v))
+}
+
+// NewContentGTE applies the GTE predicate on the "new_content" field.
+func NewContentGTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldNewContent, v))
+}
+
+// NewContentLT applies the LT predicate on the "new_content" field.
+func NewContentLT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldNewContent, v))
+}
+
+// NewContentLTE applies the LTE predicate on the "new_content" field.
+func NewContentLTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldNewContent, v))
+}
+
+// NewContentContains applies the Contains predicate on the "new_content" field.
+func NewContentContains(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContains(FieldNewContent, v))
+}
+
+// NewContentHasPrefix applies the HasPrefix predicate on the "new_content" field.
+func NewContentHasPrefix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasPrefix(FieldNewContent, v))
+}
+
+// NewContentHasSuffix applies the HasSuffix predicate on the "new_content" field.
+func NewContentHasSuffix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasSuffix(FieldNewContent, v))
+}
+
+// NewContentEqualFold applies the EqualFold predicate on the "new_content" field.
+func NewContentEqualFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEqualFold(FieldNewContent, v))
+}
+
+// NewContentContainsFold applies the ContainsFold predicate on the "new_content" field.
+func NewContentContainsFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContainsFold(FieldNewContent, v))
+}
+
+// NewCommentEQ applies the EQ predicate on the "new_comment" field.
+func NewCommentEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldNewComment, v))
+}
+
+// NewCommentNEQ applies the NEQ predicate on the "new_comment" field.
+func NewCommentNEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldNewComment, v))
+}
+
+// NewCommentIn applies the In predicate on the "new_comment" field.
+func NewCommentIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldNewComment, vs...))
+}
+
+// NewCommentNotIn applies the NotIn predicate on the "new_comment" field.
+func NewCommentNotIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldNewComment, vs...))
+}
+
+// NewCommentGT applies the GT predicate on the "new_comment" field.
+func NewCommentGT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldNewComment, v))
+}
+
+// NewCommentGTE applies the GTE predicate on the "new_comment" field.
+func NewCommentGTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldNewComment, v))
+}
+
+// NewCommentLT applies the LT predicate on the "new_comment" field.
+func NewCommentLT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldNewComment, v))
+}
+
+// NewCommentLTE applies the LTE predicate on the "new_comment" field.
+func NewCommentLTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldNewComment, v))
+}
+
+// NewCommentContains applies the Contains predicate on the "new_comment" field.
+func NewCommentContains(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContains(FieldNewComment, v))
+}
+
+// NewCommentHasPrefix applies the HasPrefix predicate on the "new_comment" field.
+func NewCommentHasPrefix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasPrefix(FieldNewComment, v))
+}
+
+// NewCommentHasSuffix applies the HasSuffix predicate on the "new_comment" field.
+func NewCommentHasSuffix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasSuffix(FieldNewComment, v))
+}
+
+// NewCommentEqualFold applies the EqualFold predicate on the "new_comment" field.
+func NewCommentEqualFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEqualFold(FieldNewComment, v))
+}
+
+// NewCommentContainsFold applies the ContainsFold predicate on the "new_comment" field.
+func NewCommentContainsFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContainsFold(FieldNewComment, v))
+}
+
+// DecisionEQ applies the EQ predicate on the "decision" field.
+func DecisionEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEQ(FieldDecision, v))
+}
+
+// DecisionNEQ applies the NEQ predicate on the "decision" field.
+func DecisionNEQ(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNEQ(FieldDecision, v))
+}
+
+// DecisionIn applies the In predicate on the "decision" field.
+func DecisionIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldIn(FieldDecision, vs...))
+}
+
+// DecisionNotIn applies the NotIn predicate on the "decision" field.
+func DecisionNotIn(vs ...string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldNotIn(FieldDecision, vs...))
+}
+
+// DecisionGT applies the GT predicate on the "decision" field.
+func DecisionGT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGT(FieldDecision, v))
+}
+
+// DecisionGTE applies the GTE predicate on the "decision" field.
+func DecisionGTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldGTE(FieldDecision, v))
+}
+
+// DecisionLT applies the LT predicate on the "decision" field.
+func DecisionLT(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLT(FieldDecision, v))
+}
+
+// DecisionLTE applies the LTE predicate on the "decision" field.
+func DecisionLTE(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldLTE(FieldDecision, v))
+}
+
+// DecisionContains applies the Contains predicate on the "decision" field.
+func DecisionContains(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContains(FieldDecision, v))
+}
+
+// DecisionHasPrefix applies the HasPrefix predicate on the "decision" field.
+func DecisionHasPrefix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasPrefix(FieldDecision, v))
+}
+
+// DecisionHasSuffix applies the HasSuffix predicate on the "decision" field.
+func DecisionHasSuffix(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldHasSuffix(FieldDecision, v))
+}
+
+// DecisionEqualFold applies the EqualFold predicate on the "decision" field.
+func DecisionEqualFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldEqualFold(FieldDecision, v))
+}
+
+// DecisionContainsFold applies the ContainsFold predicate on the "decision" field.
+func DecisionContainsFold(v string) predicate.BlockChange {
+	return predicate.BlockChange(sql.FieldContainsFold(FieldDecision, v))
+}
+
+// HasPlan applies the HasEdge predicate on the "plan" edge.
+func HasPlan() predicate.BlockChange {
+	return predicate.BlockChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlanWith applies the HasEdge predicate on the "plan" edge with a given conditions (other predicates).
+func HasPlanWith(preds ...predicate.Plan) predicate.BlockChange {
+	return predicate.BlockChange(func(s *sql.Selector) {
+		step := newPlanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BlockChange) predicate.BlockChange {
+	return predicate.BlockChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BlockChange) predicate.BlockChange {
+	return predicate.BlockChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BlockChange) predicate.BlockChange {
+	return predicate.BlockChange(sql.NotPredicates(p))
+}
@@ -0,0 +1,962 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/plan"
+)
+
+// BlockChangeCreate is the builder for creating a BlockChange entity.
+type BlockChangeCreate struct {
+	config
+	mutation *BlockChangeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlanID sets the "plan_id" field.
+func (bcc *BlockChangeCreate) SetPlanID(s string) *BlockChangeCreate {
+	bcc.mutation.SetPlanID(s)
+	return bcc
+}
+
+// SetIndex sets the "index" field.
+func (bcc *BlockChangeCreate) SetIndex(i int) *BlockChangeCreate {
+	bcc.mutation.SetIndex(i)
+	return bcc
+}
+
+// SetAction sets the "action" field.
+func (bcc *BlockChangeCreate) SetAction(s string) *BlockChangeCreate {
+	bcc.mutation.SetAction(s)
+	return bcc
+}
+
+// SetPath sets the "path" field.
+func (bcc *BlockChangeCreate) SetPath(s string) *BlockChangeCreate {
+	bcc.mutation.SetPath(s)
+	return bcc
+}
+
+// SetTargetType sets the "target_type" field.
+func (bcc *BlockChangeCreate) SetTargetType(s string) *BlockChangeCreate {
+	bcc.mutation.SetTargetType(s)
+	return bcc
+}
+
+// SetTargetName sets the "target_name" field.
+func (bcc *BlockChangeCreate) SetTargetName(s string) *BlockChangeCreate {
+	bcc.mutation.SetTargetName(s)
+	return bcc
+}
+
+// SetNewContent sets the "new_content" field.
+func (bcc *BlockChangeCreate) SetNewContent(s string) *BlockChangeCreate {
+	bcc.mutation.SetNewContent(s)
+	return bcc
+}
+
+// SetNillableNewContent sets the "new_content" field if the given value is not nil.
+func (bcc *BlockChangeCreate) SetNillableNewContent(s *string) *BlockChangeCreate {
+	if s != nil {
+		bcc.SetNewContent(*s)
+	}
+	return bcc
+}
+
+// SetNewComment sets the "new_comment" field.
+func (bcc *BlockChangeCreate) SetNewComment(s string) *BlockChangeCreate {
+	bcc.mutation.SetNewComment(s)
+	return bcc
+}
+
+// SetNillableNewComment sets the "new_comment" field if the given value is not nil.
+func (bcc *BlockChangeCreate) SetNillableNewComment(s *string) *BlockChangeCreate {
+	if s != nil {
+		bcc.SetNewComment(*s)
+	}
+	return bcc
+}
+
+// SetDecision sets the "decision" field.
+func (bcc *BlockChangeCreate) SetDecision(s string) *BlockChangeCreate {
+	bcc.mutation.SetDecision(s)
+	return bcc
+}
+
+// SetNillableDecision sets the "decision" field if the given value is not nil.
+func (bcc *BlockChangeCreate) SetNillableDecision(s *string) *BlockChangeCreate {
+	if s != nil {
+		bcc.SetDecision(*s)
+	}
+	return bcc
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (bcc *BlockChangeCreate) SetPlan(p *Plan) *BlockChangeCreate {
+	return bcc.SetPlanID(p.ID)
+}
+
+// Mutation returns the BlockChangeMutation object of the builder.
+func (bcc *BlockChangeCreate) Mutation() *BlockChangeMutation {
+	return bcc.mutation
+}
+
+// Save creates the BlockChange in the database.
+func (bcc *BlockChangeCreate) Save(ctx context.Context) (*BlockChange, error) {
+	bcc.defaults()
+	return withHooks(ctx, bcc.sqlSave, bcc.mutation, bcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bcc *BlockChangeCreate) SaveX(ctx context.Context) *BlockChange {
+	v, err := bcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcc *BlockChangeCreate) Exec(ctx context.Context) error {
+	_, err := bcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcc *BlockChangeCreate) ExecX(ctx context.Context) {
+	if err := bcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bcc *BlockChangeCreate) defaults() {
+	if _, ok := bcc.mutation.NewContent(); !ok {
+		v := blockchange.DefaultNewContent
+		bcc.mutation.SetNewContent(v)
+	}
+	if _, ok := bcc.mutation.NewComment(); !ok {
+		v := blockchange.DefaultNewComment
+		bcc.mutation.SetNewComment(v)
+	}
+	if _, ok := bcc.mutation.Decision(); !ok {
+		v := blockchange.DefaultDecision
+		bcc.mutation.SetDecision(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcc *BlockChangeCreate) check() error {
+	if _, ok := bcc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "BlockChange.plan_id"`)}
+	}
+	if _, ok := bcc.mutation.Index(); !ok {
+		return &ValidationError{Name: "index", err: errors.New(`ent: missing required field "BlockChange.index"`)}
+	}
+	if _, ok := bcc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "BlockChange.action"`)}
+	}
+	if _, ok := bcc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "BlockChange.path"`)}
+	}
+	if _, ok := bcc.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "BlockChange.target_type"`)}
+	}
+	if _, ok := bcc.mutation.TargetName(); !ok {
+		return &ValidationError{Name: "target_name", err: errors.New(`ent: missing required field "BlockChange.target_name"`)}
+	}
+	if _, ok := bcc.mutation.NewContent(); !ok {
+		return &ValidationError{Name: "new_content", err: errors.New(`ent: missing required field "BlockChange.new_content"`)}
+	}
+	if _, ok := bcc.mutation.NewComment(); !ok {
+		return &ValidationError{Name: "new_comment", err: errors.New(`ent: missing required field "BlockChange.new_comment"`)}
+	}
+	if _, ok := bcc.mutation.Decision(); !ok {
+		return &ValidationError{Name: "decision", err: errors.New(`ent: missing required field "BlockChange.decision"`)}
+	}
+	if _, ok := bcc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan", err: errors.New(`ent: missing required edge "BlockChange.plan"`)}
+	}
+	return nil
+}
+
+func (bcc *BlockChangeCreate) sqlSave(ctx context.Context) (*BlockChange, error) {
+	if err := bcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bcc.mutation.id = &_node.ID
+	bcc.mutation.done = true
+	return _node, nil
+}
+
+func (bcc *BlockChangeCreate) createSpec() (*BlockChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlockChange{config: bcc.config}
+		_spec = sqlgraph.NewCreateSpec(blockchange.Table, sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = bcc.conflict
+	if value, ok := bcc.mutation.Index(); ok {
+		_spec.SetField(blockchange.FieldIndex, field.TypeInt, value)
+		_node.Index = value
+	}
+	if value, ok := bcc.mutation.Action(); ok {
+		_spec.SetField(blockchange.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := bcc.mutation.Path(); ok {
+		_spec.SetField(blockchange.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := bcc.mutation.TargetType(); ok {
+		_spec.SetField(blockchange.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := bcc.mutation.TargetName(); ok {
+		_spec.SetField(blockchange.FieldTargetName, field.TypeString, value)
+		_node.TargetName = value
+	}
+	if value, ok := bcc.mutation.NewContent(); ok {
+		_spec.SetField(blockchange.FieldNewContent, field.TypeString, value)
+		_node.NewContent = value
+	}
+	if value, ok := bcc.mutation.NewComment(); ok {
+		_spec.SetField(blockchange.FieldNewComment, field.TypeString, value)
+		_node.NewComment = value
+	}
+	if value, ok := bcc.mutation.Decision(); ok {
+		_spec.SetField(blockchange.FieldDecision, field.TypeString, value)
+		_node.Decision = value
+	}
+	if nodes := bcc.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchange.PlanTable,
+			Columns: []string{blockchange.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlockChange.Create().
+//		SetPlanID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlockChangeUpsert) {
+//			SetPlanID(v+v).
+//		}).
+//		Exec(ctx)
+func (bcc *BlockChangeCreate) OnConflict(opts ...sql.ConflictOption) *BlockChangeUpsertOne {
+	bcc.conflict = opts
+	return &BlockChangeUpsertOne{
+		create: bcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlockChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcc *BlockChangeCreate) OnConflictColumns(columns ...string) *BlockChangeUpsertOne {
+	bcc.conflict = append(bcc.conflict, sql.ConflictColumns(columns...))
+	return &BlockChangeUpsertOne{
+		create: bcc,
+	}
+}
+
+type (
+	// BlockChangeUpsertOne is the builder for "upsert"-ing
+	//  one BlockChange node.
+	BlockChangeUpsertOne struct {
+		create *BlockChangeCreate
+	}
+
+	// BlockChangeUpsert is the "OnConflict" setter.
+	BlockChangeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlanID sets the "plan_id" field.
+func (u *BlockChangeUpsert) SetPlanID(v string) *BlockChangeUpsert {
+	u.Set(blockchange.FieldPlanID, v)
+	return u
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdatePlanID() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldPlanID)
+	return u
+}
+
+// SetIndex sets the "index" field.
+func (u *BlockChangeUpsert) SetIndex(v int) *BlockChangeUpsert {
+	u.Set(blockchange.FieldIndex, v)
+	return u
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdateIndex() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldIndex)
+	return u
+}
+
+// AddIndex adds v to the "index" field.
+func (u *BlockChangeUpsert) AddIndex(v int) *BlockChangeUpsert {
+	u.Add(blockchange.FieldIndex, v)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *BlockChangeUpsert) SetAction(v string) *BlockChangeUpsert {
+	u.Set(blockchange.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdateAction() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldAction)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *BlockChangeUpsert) SetPath(v string) *BlockChangeUpsert {
+	u.Set(blockchange.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdatePath() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldPath)
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *BlockChangeUpsert) SetTargetType(v string) *BlockChangeUpsert {
+	u.Set(blockchange.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdateTargetType() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldTargetType)
+	return u
+}
+
+// SetTargetName sets the "target_name" field.
+func (u *BlockChangeUpsert) SetTargetName(v string) *BlockChangeUpsert {
+	u.Set(blockchange.FieldTargetName, v)
+	return u
+}
+
+// UpdateTargetName sets the "target_name" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdateTargetName() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldTargetName)
+	return u
+}
+
+// SetNewContent sets the "new_content" field.
+func (u *BlockChangeUpsert) SetNewContent(v string) *BlockChangeUpsert {
+	u.Set(blockchange.FieldNewContent, v)
+	return u
+}
+
+// UpdateNewContent sets the "new_content" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdateNewContent() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldNewContent)
+	return u
+}
+
+// SetNewComment sets the "new_comment" field.
+func (u *BlockChangeUpsert) SetNewComment(v string) *BlockChangeUpsert {
+	u.Set(blockchange.FieldNewComment, v)
+	return u
+}
+
+// UpdateNewComment sets the "new_comment" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdateNewComment() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldNewComment)
+	return u
+}
+
+// SetDecision sets the "decision" field.
+func (u *BlockChangeUpsert) SetDecision(v string) *BlockChangeUpsert {
+	u.Set(blockchange.FieldDecision, v)
+	return u
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *BlockChangeUpsert) UpdateDecision() *BlockChangeUpsert {
+	u.SetExcluded(blockchange.FieldDecision)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BlockChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BlockChangeUpsertOne) UpdateNewValues() *BlockChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlockChange.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BlockChangeUpsertOne) Ignore() *BlockChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlockChangeUpsertOne) DoNothing() *BlockChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlockChangeCreate.OnConflict
+// documentation for more info.
+func (u *BlockChangeUpsertOne) Update(set func(*BlockChangeUpsert)) *BlockChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlockChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *BlockChangeUpsertOne) SetPlanID(v string) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdatePlanID() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// SetIndex sets the "index" field.
+func (u *BlockChangeUpsertOne) SetIndex(v int) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetIndex(v)
+	})
+}
+
+// AddIndex adds v to the "index" field.
+func (u *BlockChangeUpsertOne) AddIndex(v int) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.AddIndex(v)
+	})
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdateIndex() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateIndex()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *BlockChangeUpsertOne) SetAction(v string) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdateAction() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *BlockChangeUpsertOne) SetPath(v string) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdatePath() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *BlockChangeUpsertOne) SetTargetType(v string) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdateTargetType() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetName sets the "target_name" field.
+func (u *BlockChangeUpsertOne) SetTargetName(v string) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetTargetName(v)
+	})
+}
+
+// UpdateTargetName sets the "target_name" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdateTargetName() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateTargetName()
+	})
+}
+
+// SetNewContent sets the "new_content" field.
+func (u *BlockChangeUpsertOne) SetNewContent(v string) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetNewContent(v)
+	})
+}
+
+// UpdateNewContent sets the "new_content" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdateNewContent() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateNewContent()
+	})
+}
+
+// SetNewComment sets the "new_comment" field.
+func (u *BlockChangeUpsertOne) SetNewComment(v string) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetNewComment(v)
+	})
+}
+
+// UpdateNewComment sets the "new_comment" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdateNewComment() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateNewComment()
+	})
+}
+
+// SetDecision sets the "decision" field.
+func (u *BlockChangeUpsertOne) SetDecision(v string) *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetDecision(v)
+	})
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *BlockChangeUpsertOne) UpdateDecision() *BlockChangeUpsertOne {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateDecision()
+	})
+}
+
+// Exec executes the query.
+func (u *BlockChangeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlockChangeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlockChangeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BlockChangeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BlockChangeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BlockChangeCreateBulk is the builder for creating many BlockChange entities in bulk.
+type BlockChangeCreateBulk struct {
+	config
+	err      error
+	builders []*BlockChangeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BlockChange entities in the database.
+func (bccb *BlockChangeCreateBulk) Save(ctx context.Context) ([]*BlockChange, error) {
+	if bccb.err != nil {
+		return nil, bccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bccb.builders))
+	nodes := make([]*BlockChange, len(bccb.builders))
+	mutators := make([]Mutator, len(bccb.builders))
+	for i := range bccb.builders {
+		func(i int, root context.Context) {
+			builder := bccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlockChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bccb *BlockChangeCreateBulk) SaveX(ctx context.Context) []*BlockChange {
+	v, err := bccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bccb *BlockChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := bccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bccb *BlockChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := bccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlockChange.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlockChangeUpsert) {
+//			SetPlanID(v+v).
+//		}).
+//		Exec(ctx)
+func (bccb *BlockChangeCreateBulk) OnConflict(opts ...sql.ConflictOption) *BlockChangeUpsertBulk {
+	bccb.conflict = opts
+	return &BlockChangeUpsertBulk{
+		create: bccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlockChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bccb *BlockChangeCreateBulk) OnConflictColumns(columns ...string) *BlockChangeUpsertBulk {
+	bccb.conflict = append(bccb.conflict, sql.ConflictColumns(columns...))
+	return &BlockChangeUpsertBulk{
+		create: bccb,
+	}
+}
+
+// BlockChangeUpsertBulk is the builder for "upsert"-ing
+// a bulk of BlockChange nodes.
+type BlockChangeUpsertBulk struct {
+	create *BlockChangeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BlockChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BlockChangeUpsertBulk) UpdateNewValues() *BlockChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlockChange.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BlockChangeUpsertBulk) Ignore() *BlockChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlockChangeUpsertBulk) DoNothing() *BlockChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlockChangeCreateBulk.OnConflict
+// documentation for more info.
+func (u *BlockChangeUpsertBulk) Update(set func(*BlockChangeUpsert)) *BlockChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlockChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *BlockChangeUpsertBulk) SetPlanID(v string) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdatePlanID() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// SetIndex sets the "index" field.
+func (u *BlockChangeUpsertBulk) SetIndex(v int) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetIndex(v)
+	})
+}
+
+// AddIndex adds v to the "index" field.
+func (u *BlockChangeUpsertBulk) AddIndex(v int) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.AddIndex(v)
+	})
+}
+
+// UpdateIndex sets the "index" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdateIndex() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateIndex()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *BlockChangeUpsertBulk) SetAction(v string) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdateAction() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *BlockChangeUpsertBulk) SetPath(v string) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdatePath() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *BlockChangeUpsertBulk) SetTargetType(v string) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdateTargetType() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetName sets the "target_name" field.
+func (u *BlockChangeUpsertBulk) SetTargetName(v string) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetTargetName(v)
+	})
+}
+
+// UpdateTargetName sets the "target_name" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdateTargetName() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateTargetName()
+	})
+}
+
+// SetNewContent sets the "new_content" field.
+func (u *BlockChangeUpsertBulk) SetNewContent(v string) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetNewContent(v)
+	})
+}
+
+// UpdateNewContent sets the "new_content" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdateNewContent() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateNewContent()
+	})
+}
+
+// SetNewComment sets the "new_comment" field.
+func (u *BlockChangeUpsertBulk) SetNewComment(v string) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetNewComment(v)
+	})
+}
+
+// UpdateNewComment sets the "new_comment" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdateNewComment() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateNewComment()
+	})
+}
+
+// SetDecision sets the "decision" field.
+func (u *BlockChangeUpsertBulk) SetDecision(v string) *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.SetDecision(v)
+	})
+}
+
+// UpdateDecision sets the "decision" field to the value that was provided on create.
+func (u *BlockChangeUpsertBulk) UpdateDecision() *BlockChangeUpsertBulk {
+	return u.Update(func(s *BlockChangeUpsert) {
+		s.UpdateDecision()
+	})
+}
+
+// Exec executes the query.
+func (u *BlockChangeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlockChangeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlockChangeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlockChangeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/predicate"
+)
+
+// BlockChangeDelete is the builder for deleting a BlockChange entity.
+type BlockChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *BlockChangeMutation
+}
+
+// Where appends a list predicates to the BlockChangeDelete builder.
+func (bcd *BlockChangeDelete) Where(ps ...predicate.BlockChange) *BlockChangeDelete {
+	bcd.mutation.Where(ps...)
+	return bcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bcd *BlockChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bcd.sqlExec, bcd.mutation, bcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcd *BlockChangeDelete) ExecX(ctx context.Context) int {
+	n, err := bcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bcd *BlockChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(blockchange.Table, sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt))
+	if ps := bcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bcd.mutation.done = true
+	return affected, err
+}
+
+// BlockChangeDeleteOne is the builder for deleting a single BlockChange entity.
+type BlockChangeDeleteOne struct {
+	bcd *BlockChangeDelete
+}
+
+// Where appends a list predicates to the BlockChangeDelete builder.
+func (bcdo *BlockChangeDeleteOne) Where(ps ...predicate.BlockChange) *BlockChangeDeleteOne {
+	bcdo.bcd.mutation.Where(ps...)
+	return bcdo
+}
+
+// Exec executes the deletion query.
+func (bcdo *BlockChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := bcdo.bcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{blockchange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcdo *BlockChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := bcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
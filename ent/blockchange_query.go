@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/predicate"
+)
+
+// BlockChangeQuery is the builder for querying BlockChange entities.
+type BlockChangeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []blockchange.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BlockChange
+	withPlan   *PlanQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BlockChangeQuery builder.
+func (bcq *BlockChangeQuery) Where(ps ...predicate.BlockChange) *BlockChangeQuery {
+	bcq.predicates = append(bcq.predicates, ps...)
+	return bcq
+}
+
+// Limit the number of records to be returned by this query.
+func (bcq *BlockChangeQuery) Limit(limit int) *BlockChangeQuery {
+	bcq.ctx.Limit = &limit
+	return bcq
+}
+
+// Offset to start from.
+func (bcq *BlockChangeQuery) Offset(offset int) *BlockChangeQuery {
+	bcq.ctx.Offset = &offset
+	return bcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bcq *BlockChangeQuery) Unique(unique bool) *BlockChangeQuery {
+	bcq.ctx.Unique = &unique
+	return bcq
+}
+
+// Order specifies how the records should be ordered.
+func (bcq *BlockChangeQuery) Order(o ...blockchange.OrderOption) *BlockChangeQuery {
+	bcq.order = append(bcq.order, o...)
+	return bcq
+}
+
+// QueryPlan chains the current query on the "plan" edge.
+func (bcq *BlockChangeQuery) QueryPlan() *PlanQuery {
+	query := (&PlanClient{config: bcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blockchange.Table, blockchange.FieldID, selector),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blockchange.PlanTable, blockchange.PlanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BlockChange entity from the query.
+// Returns a *NotFoundError when no BlockChange was found.
+func (bcq *BlockChangeQuery) First(ctx context.Context) (*BlockChange, error) {
+	nodes, err := bcq.Limit(1).All(setContextOp(ctx, bcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{blockchange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bcq *BlockChangeQuery) FirstX(ctx context.Context) *BlockChange {
+	node, err := bcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BlockChange ID from the query.
+// Returns a *NotFoundError when no BlockChange ID was found.
+func (bcq *BlockChangeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bcq.Limit(1).IDs(setContextOp(ctx, bcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{blockchange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bcq *BlockChangeQuery) FirstIDX(ctx context.Context) int {
+	id, err := bcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BlockChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BlockChange entity is found.
+// Returns a *NotFoundError when no BlockChange entities are found.
+func (bcq *BlockChangeQuery) Only(ctx context.Context) (*BlockChange, error) {
+	nodes, err := bcq.Limit(2).All(setContextOp(ctx, bcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{blockchange.Label}
+	default:
+		return nil, &NotSingularError{blockchange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bcq *BlockChangeQuery) OnlyX(ctx context.Context) *BlockChange {
+	node, err := bcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BlockChange ID in the query.
+// Returns a *NotSingularError when more than one BlockChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bcq *BlockChangeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = bcq.Limit(2).IDs(setContextOp(ctx, bcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{blockchange.Label}
+	default:
+		err = &NotSingularError{blockchange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bcq *BlockChangeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := bcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BlockChanges.
+func (bcq *BlockChangeQuery) All(ctx context.Context) ([]*BlockChange, error) {
+	ctx = setContextOp(ctx, bcq.ctx, "All")
+	if err := bcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BlockChange, *BlockChangeQuery]()
+	return withInterceptors[[]*BlockChange](ctx, bcq, qr, bcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bcq *BlockChangeQuery) AllX(ctx context.Context) []*BlockChange {
+	nodes, err := bcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BlockChange IDs.
+func (bcq *BlockChangeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if bcq.ctx.Unique == nil && bcq.path != nil {
+		bcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bcq.ctx, "IDs")
+	if err = bcq.Select(blockchange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bcq *BlockChangeQuery) IDsX(ctx context.Context) []int {
+	ids, err := bcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bcq *BlockChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bcq.ctx, "Count")
+	if err := bcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bcq, querierCount[*BlockChangeQuery](), bcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bcq *BlockChangeQuery) CountX(ctx context.Context) int {
+	count, err := bcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bcq *BlockChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bcq.ctx, "Exist")
+	switch _, err := bcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bcq *BlockChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := bcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BlockChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bcq *BlockChangeQuery) Clone() *BlockChangeQuery {
+	if bcq == nil {
+		return nil
+	}
+	return &BlockChangeQuery{
+		config:     bcq.config,
+		ctx:        bcq.ctx.Clone(),
+		order:      append([]blockchange.OrderOption{}, bcq.order...),
+		inters:     append([]Interceptor{}, bcq.inters...),
+		predicates: append([]predicate.BlockChange{}, bcq.predicates...),
+		withPlan:   bcq.withPlan.Clone(),
+		// clone intermediate query.
+		sql:  bcq.sql.Clone(),
+		path: bcq.path,
+	}
+}
+
+// WithPlan tells the query-builder to eager-load the nodes that are connected to
+// the "plan" edge. The optional arguments are used to configure the query builder of the edge.
+func (bcq *BlockChangeQuery) WithPlan(opts ...func(*PlanQuery)) *BlockChangeQuery {
+	query := (&PlanClient{config: bcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bcq.withPlan = query
+	return bcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PlanID string `json:"plan_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BlockChange.Query().
+//		GroupBy(blockchange.FieldPlanID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bcq *BlockChangeQuery) GroupBy(field string, fields ...string) *BlockChangeGroupBy {
+	bcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BlockChangeGroupBy{build: bcq}
+	grbuild.flds = &bcq.ctx.Fields
+	grbuild.label = blockchange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PlanID string `json:"plan_id,omitempty"`
+//	}
+//
+//	client.BlockChange.Query().
+//		Select(blockchange.FieldPlanID).
+//		Scan(ctx, &v)
+func (bcq *BlockChangeQuery) Select(fields ...string) *BlockChangeSelect {
+	bcq.ctx.Fields = append(bcq.ctx.Fields, fields...)
+	sbuild := &BlockChangeSelect{BlockChangeQuery: bcq}
+	sbuild.label = blockchange.Label
+	sbuild.flds, sbuild.scan = &bcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BlockChangeSelect configured with the given aggregations.
+func (bcq *BlockChangeQuery) Aggregate(fns ...AggregateFunc) *BlockChangeSelect {
+	return bcq.Select().Aggregate(fns...)
+}
+
+func (bcq *BlockChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bcq.ctx.Fields {
+		if !blockchange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bcq.path != nil {
+		prev, err := bcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bcq.sql = prev
+	}
+	return nil
+}
+
+func (bcq *BlockChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BlockChange, error) {
+	var (
+		nodes       = []*BlockChange{}
+		_spec       = bcq.querySpec()
+		loadedTypes = [1]bool{
+			bcq.withPlan != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BlockChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BlockChange{config: bcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := bcq.withPlan; query != nil {
+		if err := bcq.loadPlan(ctx, query, nodes, nil,
+			func(n *BlockChange, e *Plan) { n.Edges.Plan = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (bcq *BlockChangeQuery) loadPlan(ctx context.Context, query *PlanQuery, nodes []*BlockChange, init func(*BlockChange), assign func(*BlockChange, *Plan)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*BlockChange)
+	for i := range nodes {
+		fk := nodes[i].PlanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(plan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "plan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (bcq *BlockChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bcq.querySpec()
+	_spec.Node.Columns = bcq.ctx.Fields
+	if len(bcq.ctx.Fields) > 0 {
+		_spec.Unique = bcq.ctx.Unique != nil && *bcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bcq.driver, _spec)
+}
+
+func (bcq *BlockChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(blockchange.Table, blockchange.Columns, sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt))
+	_spec.From = bcq.sql
+	if unique := bcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blockchange.FieldID)
+		for i := range fields {
+			if fields[i] != blockchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if bcq.withPlan != nil {
+			_spec.Node.AddColumnOnce(blockchange.FieldPlanID)
+		}
+	}
+	if ps := bcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bcq *BlockChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bcq.driver.Dialect())
+	t1 := builder.Table(blockchange.Table)
+	columns := bcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = blockchange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bcq.sql != nil {
+		selector = bcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bcq.ctx.Unique != nil && *bcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range bcq.predicates {
+		p(selector)
+	}
+	for _, p := range bcq.order {
+		p(selector)
+	}
+	if offset := bcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BlockChangeGroupBy is the group-by builder for BlockChange entities.
+type BlockChangeGroupBy struct {
+	selector
+	build *BlockChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bcgb *BlockChangeGroupBy) Aggregate(fns ...AggregateFunc) *BlockChangeGroupBy {
+	bcgb.fns = append(bcgb.fns, fns...)
+	return bcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bcgb *BlockChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bcgb.build.ctx, "GroupBy")
+	if err := bcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlockChangeQuery, *BlockChangeGroupBy](ctx, bcgb.build, bcgb, bcgb.build.inters, v)
+}
+
+func (bcgb *BlockChangeGroupBy) sqlScan(ctx context.Context, root *BlockChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bcgb.fns))
+	for _, fn := range bcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bcgb.flds)+len(bcgb.fns))
+		for _, f := range *bcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BlockChangeSelect is the builder for selecting fields of BlockChange entities.
+type BlockChangeSelect struct {
+	*BlockChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bcs *BlockChangeSelect) Aggregate(fns ...AggregateFunc) *BlockChangeSelect {
+	bcs.fns = append(bcs.fns, fns...)
+	return bcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bcs *BlockChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bcs.ctx, "Select")
+	if err := bcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlockChangeQuery, *BlockChangeSelect](ctx, bcs.BlockChangeQuery, bcs, bcs.inters, v)
+}
+
+func (bcs *BlockChangeSelect) sqlScan(ctx context.Context, root *BlockChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bcs.fns))
+	for _, fn := range bcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
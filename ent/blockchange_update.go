@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/predicate"
+)
+
+// BlockChangeUpdate is the builder for updating BlockChange entities.
+type BlockChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlockChangeMutation
+}
+
+// Where appends a list predicates to the BlockChangeUpdate builder.
+func (bcu *BlockChangeUpdate) Where(ps ...predicate.BlockChange) *BlockChangeUpdate {
+	bcu.mutation.Where(ps...)
+	return bcu
+}
+
+// SetPlanID sets the "plan_id" field.
+func (bcu *BlockChangeUpdate) SetPlanID(s string) *BlockChangeUpdate {
+	bcu.mutation.SetPlanID(s)
+	return bcu
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillablePlanID(s *string) *BlockChangeUpdate {
+	if s != nil {
+		bcu.SetPlanID(*s)
+	}
+	return bcu
+}
+
+// SetIndex sets the "index" field.
+func (bcu *BlockChangeUpdate) SetIndex(i int) *BlockChangeUpdate {
+	bcu.mutation.ResetIndex()
+	bcu.mutation.SetIndex(i)
+	return bcu
+}
+
+// SetNillableIndex sets the "index" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillableIndex(i *int) *BlockChangeUpdate {
+	if i != nil {
+		bcu.SetIndex(*i)
+	}
+	return bcu
+}
+
+// AddIndex adds i to the "index" field.
+func (bcu *BlockChangeUpdate) AddIndex(i int) *BlockChangeUpdate {
+	bcu.mutation.AddIndex(i)
+	return bcu
+}
+
+// SetAction sets the "action" field.
+func (bcu *BlockChangeUpdate) SetAction(s string) *BlockChangeUpdate {
+	bcu.mutation.SetAction(s)
+	return bcu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillableAction(s *string) *BlockChangeUpdate {
+	if s != nil {
+		bcu.SetAction(*s)
+	}
+	return bcu
+}
+
+// SetPath sets the "path" field.
+func (bcu *BlockChangeUpdate) SetPath(s string) *BlockChangeUpdate {
+	bcu.mutation.SetPath(s)
+	return bcu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillablePath(s *string) *BlockChangeUpdate {
+	if s != nil {
+		bcu.SetPath(*s)
+	}
+	return bcu
+}
+
+// SetTargetType sets the "target_type" field.
+func (bcu *BlockChangeUpdate) SetTargetType(s string) *BlockChangeUpdate {
+	bcu.mutation.SetTargetType(s)
+	return bcu
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillableTargetType(s *string) *BlockChangeUpdate {
+	if s != nil {
+		bcu.SetTargetType(*s)
+	}
+	return bcu
+}
+
+// SetTargetName sets the "target_name" field.
+func (bcu *BlockChangeUpdate) SetTargetName(s string) *BlockChangeUpdate {
+	bcu.mutation.SetTargetName(s)
+	return bcu
+}
+
+// SetNillableTargetName sets the "target_name" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillableTargetName(s *string) *BlockChangeUpdate {
+	if s != nil {
+		bcu.SetTargetName(*s)
+	}
+	return bcu
+}
+
+// SetNewContent sets the "new_content" field.
+func (bcu *BlockChangeUpdate) SetNewContent(s string) *BlockChangeUpdate {
+	bcu.mutation.SetNewContent(s)
+	return bcu
+}
+
+// SetNillableNewContent sets the "new_content" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillableNewContent(s *string) *BlockChangeUpdate {
+	if s != nil {
+		bcu.SetNewContent(*s)
+	}
+	return bcu
+}
+
+// SetNewComment sets the "new_comment" field.
+func (bcu *BlockChangeUpdate) SetNewComment(s string) *BlockChangeUpdate {
+	bcu.mutation.SetNewComment(s)
+	return bcu
+}
+
+// SetNillableNewComment sets the "new_comment" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillableNewComment(s *string) *BlockChangeUpdate {
+	if s != nil {
+		bcu.SetNewComment(*s)
+	}
+	return bcu
+}
+
+// SetDecision sets the "decision" field.
+func (bcu *BlockChangeUpdate) SetDecision(s string) *BlockChangeUpdate {
+	bcu.mutation.SetDecision(s)
+	return bcu
+}
+
+// SetNillableDecision sets the "decision" field if the given value is not nil.
+func (bcu *BlockChangeUpdate) SetNillableDecision(s *string) *BlockChangeUpdate {
+	if s != nil {
+		bcu.SetDecision(*s)
+	}
+	return bcu
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (bcu *BlockChangeUpdate) SetPlan(p *Plan) *BlockChangeUpdate {
+	return bcu.SetPlanID(p.ID)
+}
+
+// Mutation returns the BlockChangeMutation object of the builder.
+func (bcu *BlockChangeUpdate) Mutation() *BlockChangeMutation {
+	return bcu.mutation
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (bcu *BlockChangeUpdate) ClearPlan() *BlockChangeUpdate {
+	bcu.mutation.ClearPlan()
+	return bcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bcu *BlockChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bcu.sqlSave, bcu.mutation, bcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcu *BlockChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := bcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bcu *BlockChangeUpdate) Exec(ctx context.Context) error {
+	_, err := bcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcu *BlockChangeUpdate) ExecX(ctx context.Context) {
+	if err := bcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcu *BlockChangeUpdate) check() error {
+	if _, ok := bcu.mutation.PlanID(); bcu.mutation.PlanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BlockChange.plan"`)
+	}
+	return nil
+}
+
+func (bcu *BlockChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blockchange.Table, blockchange.Columns, sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt))
+	if ps := bcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bcu.mutation.Index(); ok {
+		_spec.SetField(blockchange.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := bcu.mutation.AddedIndex(); ok {
+		_spec.AddField(blockchange.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := bcu.mutation.Action(); ok {
+		_spec.SetField(blockchange.FieldAction, field.TypeString, value)
+	}
+	if value, ok := bcu.mutation.Path(); ok {
+		_spec.SetField(blockchange.FieldPath, field.TypeString, value)
+	}
+	if value, ok := bcu.mutation.TargetType(); ok {
+		_spec.SetField(blockchange.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := bcu.mutation.TargetName(); ok {
+		_spec.SetField(blockchange.FieldTargetName, field.TypeString, value)
+	}
+	if value, ok := bcu.mutation.NewContent(); ok {
+		_spec.SetField(blockchange.FieldNewContent, field.TypeString, value)
+	}
+	if value, ok := bcu.mutation.NewComment(); ok {
+		_spec.SetField(blockchange.FieldNewComment, field.TypeString, value)
+	}
+	if value, ok := bcu.mutation.Decision(); ok {
+		_spec.SetField(blockchange.FieldDecision, field.TypeString, value)
+	}
+	if bcu.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchange.PlanTable,
+			Columns: []string{blockchange.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bcu.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchange.PlanTable,
+			Columns: []string{blockchange.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blockchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bcu.mutation.done = true
+	return n, nil
+}
+
+// BlockChangeUpdateOne is the builder for updating a single BlockChange entity.
+type BlockChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlockChangeMutation
+}
+
+// SetPlanID sets the "plan_id" field.
+func (bcuo *BlockChangeUpdateOne) SetPlanID(s string) *BlockChangeUpdateOne {
+	bcuo.mutation.SetPlanID(s)
+	return bcuo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillablePlanID(s *string) *BlockChangeUpdateOne {
+	if s != nil {
+		bcuo.SetPlanID(*s)
+	}
+	return bcuo
+}
+
+// SetIndex sets the "index" field.
+func (bcuo *BlockChangeUpdateOne) SetIndex(i int) *BlockChangeUpdateOne {
+	bcuo.mutation.ResetIndex()
+	bcuo.mutation.SetIndex(i)
+	return bcuo
+}
+
+// SetNillableIndex sets the "index" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillableIndex(i *int) *BlockChangeUpdateOne {
+	if i != nil {
+		bcuo.SetIndex(*i)
+	}
+	return bcuo
+}
+
+// AddIndex adds i to the "index" field.
+func (bcuo *BlockChangeUpdateOne) AddIndex(i int) *BlockChangeUpdateOne {
+	bcuo.mutation.AddIndex(i)
+	return bcuo
+}
+
+// SetAction sets the "action" field.
+func (bcuo *BlockChangeUpdateOne) SetAction(s string) *BlockChangeUpdateOne {
+	bcuo.mutation.SetAction(s)
+	return bcuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillableAction(s *string) *BlockChangeUpdateOne {
+	if s != nil {
+		bcuo.SetAction(*s)
+	}
+	return bcuo
+}
+
+// SetPath sets the "path" field.
+func (bcuo *BlockChangeUpdateOne) SetPath(s string) *BlockChangeUpdateOne {
+	bcuo.mutation.SetPath(s)
+	return bcuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillablePath(s *string) *BlockChangeUpdateOne {
+	if s != nil {
+		bcuo.SetPath(*s)
+	}
+	return bcuo
+}
+
+// SetTargetType sets the "target_type" field.
+func (bcuo *BlockChangeUpdateOne) SetTargetType(s string) *BlockChangeUpdateOne {
+	bcuo.mutation.SetTargetType(s)
+	return bcuo
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillableTargetType(s *string) *BlockChangeUpdateOne {
+	if s != nil {
+		bcuo.SetTargetType(*s)
+	}
+	return bcuo
+}
+
+// SetTargetName sets the "target_name" field.
+func (bcuo *BlockChangeUpdateOne) SetTargetName(s string) *BlockChangeUpdateOne {
+	bcuo.mutation.SetTargetName(s)
+	return bcuo
+}
+
+// SetNillableTargetName sets the "target_name" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillableTargetName(s *string) *BlockChangeUpdateOne {
+	if s != nil {
+		bcuo.SetTargetName(*s)
+	}
+	return bcuo
+}
+
+// SetNewContent sets the "new_content" field.
+func (bcuo *BlockChangeUpdateOne) SetNewContent(s string) *BlockChangeUpdateOne {
+	bcuo.mutation.SetNewContent(s)
+	return bcuo
+}
+
+// SetNillableNewContent sets the "new_content" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillableNewContent(s *string) *BlockChangeUpdateOne {
+	if s != nil {
+		bcuo.SetNewContent(*s)
+	}
+	return bcuo
+}
+
+// SetNewComment sets the "new_comment" field.
+func (bcuo *BlockChangeUpdateOne) SetNewComment(s string) *BlockChangeUpdateOne {
+	bcuo.mutation.SetNewComment(s)
+	return bcuo
+}
+
+// SetNillableNewComment sets the "new_comment" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillableNewComment(s *string) *BlockChangeUpdateOne {
+	if s != nil {
+		bcuo.SetNewComment(*s)
+	}
+	return bcuo
+}
+
+// SetDecision sets the "decision" field.
+func (bcuo *BlockChangeUpdateOne) SetDecision(s string) *BlockChangeUpdateOne {
+	bcuo.mutation.SetDecision(s)
+	return bcuo
+}
+
+// SetNillableDecision sets the "decision" field if the given value is not nil.
+func (bcuo *BlockChangeUpdateOne) SetNillableDecision(s *string) *BlockChangeUpdateOne {
+	if s != nil {
+		bcuo.SetDecision(*s)
+	}
+	return bcuo
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (bcuo *BlockChangeUpdateOne) SetPlan(p *Plan) *BlockChangeUpdateOne {
+	return bcuo.SetPlanID(p.ID)
+}
+
+// Mutation returns the BlockChangeMutation object of the builder.
+func (bcuo *BlockChangeUpdateOne) Mutation() *BlockChangeMutation {
+	return bcuo.mutation
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (bcuo *BlockChangeUpdateOne) ClearPlan() *BlockChangeUpdateOne {
+	bcuo.mutation.ClearPlan()
+	return bcuo
+}
+
+// Where appends a list predicates to the BlockChangeUpdate builder.
+func (bcuo *BlockChangeUpdateOne) Where(ps ...predicate.BlockChange) *BlockChangeUpdateOne {
+	bcuo.mutation.Where(ps...)
+	return bcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bcuo *BlockChangeUpdateOne) Select(field string, fields ...string) *BlockChangeUpdateOne {
+	bcuo.fields = append([]string{field}, fields...)
+	return bcuo
+}
+
+// Save executes the query and returns the updated BlockChange entity.
+func (bcuo *BlockChangeUpdateOne) Save(ctx context.Context) (*BlockChange, error) {
+	return withHooks(ctx, bcuo.sqlSave, bcuo.mutation, bcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcuo *BlockChangeUpdateOne) SaveX(ctx context.Context) *BlockChange {
+	node, err := bcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bcuo *BlockChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := bcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcuo *BlockChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := bcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bcuo *BlockChangeUpdateOne) check() error {
+	if _, ok := bcuo.mutation.PlanID(); bcuo.mutation.PlanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BlockChange.plan"`)
+	}
+	return nil
+}
+
+func (bcuo *BlockChangeUpdateOne) sqlSave(ctx context.Context) (_node *BlockChange, err error) {
+	if err := bcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blockchange.Table, blockchange.Columns, sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt))
+	id, ok := bcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlockChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blockchange.FieldID)
+		for _, f := range fields {
+			if !blockchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blockchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bcuo.mutation.Index(); ok {
+		_spec.SetField(blockchange.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := bcuo.mutation.AddedIndex(); ok {
+		_spec.AddField(blockchange.FieldIndex, field.TypeInt, value)
+	}
+	if value, ok := bcuo.mutation.Action(); ok {
+		_spec.SetField(blockchange.FieldAction, field.TypeString, value)
+	}
+	if value, ok := bcuo.mutation.Path(); ok {
+		_spec.SetField(blockchange.FieldPath, field.TypeString, value)
+	}
+	if value, ok := bcuo.mutation.TargetType(); ok {
+		_spec.SetField(blockchange.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := bcuo.mutation.TargetName(); ok {
+		_spec.SetField(blockchange.FieldTargetName, field.TypeString, value)
+	}
+	if value, ok := bcuo.mutation.NewContent(); ok {
+		_spec.SetField(blockchange.FieldNewContent, field.TypeString, value)
+	}
+	if value, ok := bcuo.mutation.NewComment(); ok {
+		_spec.SetField(blockchange.FieldNewComment, field.TypeString, value)
+	}
+	if value, ok := bcuo.mutation.Decision(); ok {
+		_spec.SetField(blockchange.FieldDecision, field.TypeString, value)
+	}
+	if bcuo.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchange.PlanTable,
+			Columns: []string{blockchange.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bcuo.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockchange.PlanTable,
+			Columns: []string{blockchange.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BlockChange{config: bcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blockchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bcuo.mutation.done = true
+	return _node, nil
+}
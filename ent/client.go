@@ -14,7 +14,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/blockchange"
 	"github.com/nakamasato/aicoder/ent/document"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/review"
 )
 
 // Client is the client that holds all ent builders.
@@ -22,8 +27,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApplyRun is the client for interacting with the ApplyRun builders.
+	ApplyRun *ApplyRunClient
+	// BlockChange is the client for interacting with the BlockChange builders.
+	BlockChange *BlockChangeClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
+	// Plan is the client for interacting with the Plan builders.
+	Plan *PlanClient
+	// Review is the client for interacting with the Review builders.
+	Review *ReviewClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -35,7 +48,11 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApplyRun = NewApplyRunClient(c.config)
+	c.BlockChange = NewBlockChangeClient(c.config)
 	c.Document = NewDocumentClient(c.config)
+	c.Plan = NewPlanClient(c.config)
+	c.Review = NewReviewClient(c.config)
 }
 
 type (
@@ -126,9 +143,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Document: NewDocumentClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		ApplyRun:    NewApplyRunClient(cfg),
+		BlockChange: NewBlockChangeClient(cfg),
+		Document:    NewDocumentClient(cfg),
+		Plan:        NewPlanClient(cfg),
+		Review:      NewReviewClient(cfg),
 	}, nil
 }
 
@@ -146,16 +167,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Document: NewDocumentClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		ApplyRun:    NewApplyRunClient(cfg),
+		BlockChange: NewBlockChangeClient(cfg),
+		Document:    NewDocumentClient(cfg),
+		Plan:        NewPlanClient(cfg),
+		Review:      NewReviewClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Document.
+//		ApplyRun.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -177,25 +202,339 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.ApplyRun.Use(hooks...)
+	c.BlockChange.Use(hooks...)
 	c.Document.Use(hooks...)
+	c.Plan.Use(hooks...)
+	c.Review.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.ApplyRun.Intercept(interceptors...)
+	c.BlockChange.Intercept(interceptors...)
 	c.Document.Intercept(interceptors...)
+	c.Plan.Intercept(interceptors...)
+	c.Review.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ApplyRunMutation:
+		return c.ApplyRun.mutate(ctx, m)
+	case *BlockChangeMutation:
+		return c.BlockChange.mutate(ctx, m)
 	case *DocumentMutation:
 		return c.Document.mutate(ctx, m)
+	case *PlanMutation:
+		return c.Plan.mutate(ctx, m)
+	case *ReviewMutation:
+		return c.Review.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// ApplyRunClient is a client for the ApplyRun schema.
+type ApplyRunClient struct {
+	config
+}
+
+// NewApplyRunClient returns a client for the ApplyRun from the given config.
+func NewApplyRunClient(c config) *ApplyRunClient {
+	return &ApplyRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `applyrun.Hooks(f(g(h())))`.
+func (c *ApplyRunClient) Use(hooks ...Hook) {
+	c.hooks.ApplyRun = append(c.hooks.ApplyRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `applyrun.Intercept(f(g(h())))`.
+func (c *ApplyRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApplyRun = append(c.inters.ApplyRun, interceptors...)
+}
+
+// Create returns a builder for creating a ApplyRun entity.
+func (c *ApplyRunClient) Create() *ApplyRunCreate {
+	mutation := newApplyRunMutation(c.config, OpCreate)
+	return &ApplyRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApplyRun entities.
+func (c *ApplyRunClient) CreateBulk(builders ...*ApplyRunCreate) *ApplyRunCreateBulk {
+	return &ApplyRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApplyRunClient) MapCreateBulk(slice any, setFunc func(*ApplyRunCreate, int)) *ApplyRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApplyRunCreateBulk{err: fmt.Errorf("calling to ApplyRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApplyRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApplyRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApplyRun.
+func (c *ApplyRunClient) Update() *ApplyRunUpdate {
+	mutation := newApplyRunMutation(c.config, OpUpdate)
+	return &ApplyRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApplyRunClient) UpdateOne(ar *ApplyRun) *ApplyRunUpdateOne {
+	mutation := newApplyRunMutation(c.config, OpUpdateOne, withApplyRun(ar))
+	return &ApplyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApplyRunClient) UpdateOneID(id int) *ApplyRunUpdateOne {
+	mutation := newApplyRunMutation(c.config, OpUpdateOne, withApplyRunID(id))
+	return &ApplyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApplyRun.
+func (c *ApplyRunClient) Delete() *ApplyRunDelete {
+	mutation := newApplyRunMutation(c.config, OpDelete)
+	return &ApplyRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApplyRunClient) DeleteOne(ar *ApplyRun) *ApplyRunDeleteOne {
+	return c.DeleteOneID(ar.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApplyRunClient) DeleteOneID(id int) *ApplyRunDeleteOne {
+	builder := c.Delete().Where(applyrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApplyRunDeleteOne{builder}
+}
+
+// Query returns a query builder for ApplyRun.
+func (c *ApplyRunClient) Query() *ApplyRunQuery {
+	return &ApplyRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApplyRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApplyRun entity by its id.
+func (c *ApplyRunClient) Get(ctx context.Context, id int) (*ApplyRun, error) {
+	return c.Query().Where(applyrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApplyRunClient) GetX(ctx context.Context, id int) *ApplyRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlan queries the plan edge of a ApplyRun.
+func (c *ApplyRunClient) QueryPlan(ar *ApplyRun) *PlanQuery {
+	query := (&PlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ar.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(applyrun.Table, applyrun.FieldID, id),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, applyrun.PlanTable, applyrun.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(ar.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ApplyRunClient) Hooks() []Hook {
+	return c.hooks.ApplyRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApplyRunClient) Interceptors() []Interceptor {
+	return c.inters.ApplyRun
+}
+
+func (c *ApplyRunClient) mutate(ctx context.Context, m *ApplyRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApplyRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApplyRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApplyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApplyRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApplyRun mutation op: %q", m.Op())
+	}
+}
+
+// BlockChangeClient is a client for the BlockChange schema.
+type BlockChangeClient struct {
+	config
+}
+
+// NewBlockChangeClient returns a client for the BlockChange from the given config.
+func NewBlockChangeClient(c config) *BlockChangeClient {
+	return &BlockChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `blockchange.Hooks(f(g(h())))`.
+func (c *BlockChangeClient) Use(hooks ...Hook) {
+	c.hooks.BlockChange = append(c.hooks.BlockChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `blockchange.Intercept(f(g(h())))`.
+func (c *BlockChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BlockChange = append(c.inters.BlockChange, interceptors...)
+}
+
+// Create returns a builder for creating a BlockChange entity.
+func (c *BlockChangeClient) Create() *BlockChangeCreate {
+	mutation := newBlockChangeMutation(c.config, OpCreate)
+	return &BlockChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BlockChange entities.
+func (c *BlockChangeClient) CreateBulk(builders ...*BlockChangeCreate) *BlockChangeCreateBulk {
+	return &BlockChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlockChangeClient) MapCreateBulk(slice any, setFunc func(*BlockChangeCreate, int)) *BlockChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlockChangeCreateBulk{err: fmt.Errorf("calling to BlockChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlockChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlockChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BlockChange.
+func (c *BlockChangeClient) Update() *BlockChangeUpdate {
+	mutation := newBlockChangeMutation(c.config, OpUpdate)
+	return &BlockChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlockChangeClient) UpdateOne(bc *BlockChange) *BlockChangeUpdateOne {
+	mutation := newBlockChangeMutation(c.config, OpUpdateOne, withBlockChange(bc))
+	return &BlockChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlockChangeClient) UpdateOneID(id int) *BlockChangeUpdateOne {
+	mutation := newBlockChangeMutation(c.config, OpUpdateOne, withBlockChangeID(id))
+	return &BlockChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BlockChange.
+func (c *BlockChangeClient) Delete() *BlockChangeDelete {
+	mutation := newBlockChangeMutation(c.config, OpDelete)
+	return &BlockChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlockChangeClient) DeleteOne(bc *BlockChange) *BlockChangeDeleteOne {
+	return c.DeleteOneID(bc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlockChangeClient) DeleteOneID(id int) *BlockChangeDeleteOne {
+	builder := c.Delete().Where(blockchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlockChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for BlockChange.
+func (c *BlockChangeClient) Query() *BlockChangeQuery {
+	return &BlockChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlockChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BlockChange entity by its id.
+func (c *BlockChangeClient) Get(ctx context.Context, id int) (*BlockChange, error) {
+	return c.Query().Where(blockchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlockChangeClient) GetX(ctx context.Context, id int) *BlockChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlan queries the plan edge of a BlockChange.
+func (c *BlockChangeClient) QueryPlan(bc *BlockChange) *PlanQuery {
+	query := (&PlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blockchange.Table, blockchange.FieldID, id),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blockchange.PlanTable, blockchange.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(bc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BlockChangeClient) Hooks() []Hook {
+	return c.hooks.BlockChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlockChangeClient) Interceptors() []Interceptor {
+	return c.inters.BlockChange
+}
+
+func (c *BlockChangeClient) mutate(ctx context.Context, m *BlockChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlockChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlockChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlockChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlockChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BlockChange mutation op: %q", m.Op())
+	}
+}
+
 // DocumentClient is a client for the Document schema.
 type DocumentClient struct {
 	config
@@ -329,12 +668,342 @@ func (c *DocumentClient) mutate(ctx context.Context, m *DocumentMutation) (Value
 	}
 }
 
+// PlanClient is a client for the Plan schema.
+type PlanClient struct {
+	config
+}
+
+// NewPlanClient returns a client for the Plan from the given config.
+func NewPlanClient(c config) *PlanClient {
+	return &PlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `plan.Hooks(f(g(h())))`.
+func (c *PlanClient) Use(hooks ...Hook) {
+	c.hooks.Plan = append(c.hooks.Plan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `plan.Intercept(f(g(h())))`.
+func (c *PlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Plan = append(c.inters.Plan, interceptors...)
+}
+
+// Create returns a builder for creating a Plan entity.
+func (c *PlanClient) Create() *PlanCreate {
+	mutation := newPlanMutation(c.config, OpCreate)
+	return &PlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Plan entities.
+func (c *PlanClient) CreateBulk(builders ...*PlanCreate) *PlanCreateBulk {
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlanClient) MapCreateBulk(slice any, setFunc func(*PlanCreate, int)) *PlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlanCreateBulk{err: fmt.Errorf("calling to PlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Plan.
+func (c *PlanClient) Update() *PlanUpdate {
+	mutation := newPlanMutation(c.config, OpUpdate)
+	return &PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlanClient) UpdateOne(pl *Plan) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlan(pl))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlanClient) UpdateOneID(id string) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlanID(id))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Plan.
+func (c *PlanClient) Delete() *PlanDelete {
+	mutation := newPlanMutation(c.config, OpDelete)
+	return &PlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlanClient) DeleteOne(pl *Plan) *PlanDeleteOne {
+	return c.DeleteOneID(pl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlanClient) DeleteOneID(id string) *PlanDeleteOne {
+	builder := c.Delete().Where(plan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlanDeleteOne{builder}
+}
+
+// Query returns a query builder for Plan.
+func (c *PlanClient) Query() *PlanQuery {
+	return &PlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Plan entity by its id.
+func (c *PlanClient) Get(ctx context.Context, id string) (*Plan, error) {
+	return c.Query().Where(plan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlanClient) GetX(ctx context.Context, id string) *Plan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBlockChanges queries the block_changes edge of a Plan.
+func (c *PlanClient) QueryBlockChanges(pl *Plan) *BlockChangeQuery {
+	query := (&BlockChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(plan.Table, plan.FieldID, id),
+			sqlgraph.To(blockchange.Table, blockchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, plan.BlockChangesTable, plan.BlockChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviews queries the reviews edge of a Plan.
+func (c *PlanClient) QueryReviews(pl *Plan) *ReviewQuery {
+	query := (&ReviewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(plan.Table, plan.FieldID, id),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, plan.ReviewsTable, plan.ReviewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApplyRuns queries the apply_runs edge of a Plan.
+func (c *PlanClient) QueryApplyRuns(pl *Plan) *ApplyRunQuery {
+	query := (&ApplyRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(plan.Table, plan.FieldID, id),
+			sqlgraph.To(applyrun.Table, applyrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, plan.ApplyRunsTable, plan.ApplyRunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlanClient) Hooks() []Hook {
+	return c.hooks.Plan
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlanClient) Interceptors() []Interceptor {
+	return c.inters.Plan
+}
+
+func (c *PlanClient) mutate(ctx context.Context, m *PlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Plan mutation op: %q", m.Op())
+	}
+}
+
+// ReviewClient is a client for the Review schema.
+type ReviewClient struct {
+	config
+}
+
+// NewReviewClient returns a client for the Review from the given config.
+func NewReviewClient(c config) *ReviewClient {
+	return &ReviewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `review.Hooks(f(g(h())))`.
+func (c *ReviewClient) Use(hooks ...Hook) {
+	c.hooks.Review = append(c.hooks.Review, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `review.Intercept(f(g(h())))`.
+func (c *ReviewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Review = append(c.inters.Review, interceptors...)
+}
+
+// Create returns a builder for creating a Review entity.
+func (c *ReviewClient) Create() *ReviewCreate {
+	mutation := newReviewMutation(c.config, OpCreate)
+	return &ReviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Review entities.
+func (c *ReviewClient) CreateBulk(builders ...*ReviewCreate) *ReviewCreateBulk {
+	return &ReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReviewClient) MapCreateBulk(slice any, setFunc func(*ReviewCreate, int)) *ReviewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReviewCreateBulk{err: fmt.Errorf("calling to ReviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReviewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Review.
+func (c *ReviewClient) Update() *ReviewUpdate {
+	mutation := newReviewMutation(c.config, OpUpdate)
+	return &ReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewClient) UpdateOne(r *Review) *ReviewUpdateOne {
+	mutation := newReviewMutation(c.config, OpUpdateOne, withReview(r))
+	return &ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewClient) UpdateOneID(id int) *ReviewUpdateOne {
+	mutation := newReviewMutation(c.config, OpUpdateOne, withReviewID(id))
+	return &ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Review.
+func (c *ReviewClient) Delete() *ReviewDelete {
+	mutation := newReviewMutation(c.config, OpDelete)
+	return &ReviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewClient) DeleteOne(r *Review) *ReviewDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReviewClient) DeleteOneID(id int) *ReviewDeleteOne {
+	builder := c.Delete().Where(review.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewDeleteOne{builder}
+}
+
+// Query returns a query builder for Review.
+func (c *ReviewClient) Query() *ReviewQuery {
+	return &ReviewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReview},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Review entity by its id.
+func (c *ReviewClient) Get(ctx context.Context, id int) (*Review, error) {
+	return c.Query().Where(review.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewClient) GetX(ctx context.Context, id int) *Review {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlan queries the plan edge of a Review.
+func (c *ReviewClient) QueryPlan(r *Review) *PlanQuery {
+	query := (&PlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, review.PlanTable, review.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewClient) Hooks() []Hook {
+	return c.hooks.Review
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReviewClient) Interceptors() []Interceptor {
+	return c.inters.Review
+}
+
+func (c *ReviewClient) mutate(ctx context.Context, m *ReviewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReviewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReviewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Review mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Document []ent.Hook
+		ApplyRun, BlockChange, Document, Plan, Review []ent.Hook
 	}
 	inters struct {
-		Document []ent.Interceptor
+		ApplyRun, BlockChange, Document, Plan, Review []ent.Interceptor
 	}
 )
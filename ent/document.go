@@ -26,6 +26,10 @@ type Document struct {
 	Filepath string `json:"filepath,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// BlobHash holds the value of the "blob_hash" field.
+	BlobHash string `json:"blob_hash,omitempty"`
+	// EmbeddingModel holds the value of the "embedding_model" field.
+	EmbeddingModel string `json:"embedding_model,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Embedding holds the value of the "embedding" field.
@@ -42,7 +46,7 @@ func (*Document) scanValues(columns []string) ([]any, error) {
 			values[i] = new(pgvector.Vector)
 		case document.FieldID:
 			values[i] = new(sql.NullInt64)
-		case document.FieldRepository, document.FieldContext, document.FieldFilepath, document.FieldDescription:
+		case document.FieldRepository, document.FieldContext, document.FieldFilepath, document.FieldDescription, document.FieldBlobHash, document.FieldEmbeddingModel:
 			values[i] = new(sql.NullString)
 		case document.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -91,6 +95,18 @@ func (d *Document) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.Description = value.String
 			}
+		case document.FieldBlobHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_hash", values[i])
+			} else if value.Valid {
+				d.BlobHash = value.String
+			}
+		case document.FieldEmbeddingModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_model", values[i])
+			} else if value.Valid {
+				d.EmbeddingModel = value.String
+			}
 		case document.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -151,6 +167,12 @@ func (d *Document) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(d.Description)
 	builder.WriteString(", ")
+	builder.WriteString("blob_hash=")
+	builder.WriteString(d.BlobHash)
+	builder.WriteString(", ")
+	builder.WriteString("embedding_model=")
+	builder.WriteString(d.EmbeddingModel)
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -19,6 +19,10 @@ const (
 	FieldFilepath = "filepath"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldBlobHash holds the string denoting the blob_hash field in the database.
+	FieldBlobHash = "blob_hash"
+	// FieldEmbeddingModel holds the string denoting the embedding_model field in the database.
+	FieldEmbeddingModel = "embedding_model"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldEmbedding holds the string denoting the embedding field in the database.
@@ -34,6 +38,8 @@ var Columns = []string{
 	FieldContext,
 	FieldFilepath,
 	FieldDescription,
+	FieldBlobHash,
+	FieldEmbeddingModel,
 	FieldUpdatedAt,
 	FieldEmbedding,
 }
@@ -48,6 +54,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultBlobHash holds the default value on creation for the "blob_hash" field.
+	DefaultBlobHash string
+	// DefaultEmbeddingModel holds the default value on creation for the "embedding_model" field.
+	DefaultEmbeddingModel string
+)
+
 // OrderOption defines the ordering options for the Document queries.
 type OrderOption func(*sql.Selector)
 
@@ -76,6 +89,16 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByBlobHash orders the results by the blob_hash field.
+func ByBlobHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlobHash, opts...).ToFunc()
+}
+
+// ByEmbeddingModel orders the results by the embedding_model field.
+func ByEmbeddingModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingModel, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -75,6 +75,16 @@ func Description(v string) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldDescription, v))
 }
 
+// BlobHash applies equality check predicate on the "blob_hash" field. It's identical to BlobHashEQ.
+func BlobHash(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldBlobHash, v))
+}
+
+// EmbeddingModel applies equality check predicate on the "embedding_model" field. It's identical to EmbeddingModelEQ.
+func EmbeddingModel(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldUpdatedAt, v))
@@ -345,6 +355,136 @@ func DescriptionContainsFold(v string) predicate.Document {
 	return predicate.Document(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// BlobHashEQ applies the EQ predicate on the "blob_hash" field.
+func BlobHashEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldBlobHash, v))
+}
+
+// BlobHashNEQ applies the NEQ predicate on the "blob_hash" field.
+func BlobHashNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldBlobHash, v))
+}
+
+// BlobHashIn applies the In predicate on the "blob_hash" field.
+func BlobHashIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldBlobHash, vs...))
+}
+
+// BlobHashNotIn applies the NotIn predicate on the "blob_hash" field.
+func BlobHashNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldBlobHash, vs...))
+}
+
+// BlobHashGT applies the GT predicate on the "blob_hash" field.
+func BlobHashGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldBlobHash, v))
+}
+
+// BlobHashGTE applies the GTE predicate on the "blob_hash" field.
+func BlobHashGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldBlobHash, v))
+}
+
+// BlobHashLT applies the LT predicate on the "blob_hash" field.
+func BlobHashLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldBlobHash, v))
+}
+
+// BlobHashLTE applies the LTE predicate on the "blob_hash" field.
+func BlobHashLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldBlobHash, v))
+}
+
+// BlobHashContains applies the Contains predicate on the "blob_hash" field.
+func BlobHashContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldBlobHash, v))
+}
+
+// BlobHashHasPrefix applies the HasPrefix predicate on the "blob_hash" field.
+func BlobHashHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldBlobHash, v))
+}
+
+// BlobHashHasSuffix applies the HasSuffix predicate on the "blob_hash" field.
+func BlobHashHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldBlobHash, v))
+}
+
+// BlobHashEqualFold applies the EqualFold predicate on the "blob_hash" field.
+func BlobHashEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldBlobHash, v))
+}
+
+// BlobHashContainsFold applies the ContainsFold predicate on the "blob_hash" field.
+func BlobHashContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldBlobHash, v))
+}
+
+// EmbeddingModelEQ applies the EQ predicate on the "embedding_model" field.
+func EmbeddingModelEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelNEQ applies the NEQ predicate on the "embedding_model" field.
+func EmbeddingModelNEQ(v string) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelIn applies the In predicate on the "embedding_model" field.
+func EmbeddingModelIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelNotIn applies the NotIn predicate on the "embedding_model" field.
+func EmbeddingModelNotIn(vs ...string) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldEmbeddingModel, vs...))
+}
+
+// EmbeddingModelGT applies the GT predicate on the "embedding_model" field.
+func EmbeddingModelGT(v string) predicate.Document {
+	return predicate.Document(sql.FieldGT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelGTE applies the GTE predicate on the "embedding_model" field.
+func EmbeddingModelGTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldGTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLT applies the LT predicate on the "embedding_model" field.
+func EmbeddingModelLT(v string) predicate.Document {
+	return predicate.Document(sql.FieldLT(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelLTE applies the LTE predicate on the "embedding_model" field.
+func EmbeddingModelLTE(v string) predicate.Document {
+	return predicate.Document(sql.FieldLTE(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContains applies the Contains predicate on the "embedding_model" field.
+func EmbeddingModelContains(v string) predicate.Document {
+	return predicate.Document(sql.FieldContains(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasPrefix applies the HasPrefix predicate on the "embedding_model" field.
+func EmbeddingModelHasPrefix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasPrefix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelHasSuffix applies the HasSuffix predicate on the "embedding_model" field.
+func EmbeddingModelHasSuffix(v string) predicate.Document {
+	return predicate.Document(sql.FieldHasSuffix(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelEqualFold applies the EqualFold predicate on the "embedding_model" field.
+func EmbeddingModelEqualFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldEqualFold(FieldEmbeddingModel, v))
+}
+
+// EmbeddingModelContainsFold applies the ContainsFold predicate on the "embedding_model" field.
+func EmbeddingModelContainsFold(v string) predicate.Document {
+	return predicate.Document(sql.FieldContainsFold(FieldEmbeddingModel, v))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldUpdatedAt, v))
@@ -47,6 +47,34 @@ func (dc *DocumentCreate) SetDescription(s string) *DocumentCreate {
 	return dc
 }
 
+// SetBlobHash sets the "blob_hash" field.
+func (dc *DocumentCreate) SetBlobHash(s string) *DocumentCreate {
+	dc.mutation.SetBlobHash(s)
+	return dc
+}
+
+// SetNillableBlobHash sets the "blob_hash" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableBlobHash(s *string) *DocumentCreate {
+	if s != nil {
+		dc.SetBlobHash(*s)
+	}
+	return dc
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (dc *DocumentCreate) SetEmbeddingModel(s string) *DocumentCreate {
+	dc.mutation.SetEmbeddingModel(s)
+	return dc
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableEmbeddingModel(s *string) *DocumentCreate {
+	if s != nil {
+		dc.SetEmbeddingModel(*s)
+	}
+	return dc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (dc *DocumentCreate) SetUpdatedAt(t time.Time) *DocumentCreate {
 	dc.mutation.SetUpdatedAt(t)
@@ -72,6 +100,7 @@ func (dc *DocumentCreate) Mutation() *DocumentMutation {
 
 // Save creates the Document in the database.
 func (dc *DocumentCreate) Save(ctx context.Context) (*Document, error) {
+	dc.defaults()
 	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
 }
 
@@ -97,6 +126,18 @@ func (dc *DocumentCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (dc *DocumentCreate) defaults() {
+	if _, ok := dc.mutation.BlobHash(); !ok {
+		v := document.DefaultBlobHash
+		dc.mutation.SetBlobHash(v)
+	}
+	if _, ok := dc.mutation.EmbeddingModel(); !ok {
+		v := document.DefaultEmbeddingModel
+		dc.mutation.SetEmbeddingModel(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (dc *DocumentCreate) check() error {
 	if _, ok := dc.mutation.Repository(); !ok {
@@ -111,6 +152,12 @@ func (dc *DocumentCreate) check() error {
 	if _, ok := dc.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Document.description"`)}
 	}
+	if _, ok := dc.mutation.BlobHash(); !ok {
+		return &ValidationError{Name: "blob_hash", err: errors.New(`ent: missing required field "Document.blob_hash"`)}
+	}
+	if _, ok := dc.mutation.EmbeddingModel(); !ok {
+		return &ValidationError{Name: "embedding_model", err: errors.New(`ent: missing required field "Document.embedding_model"`)}
+	}
 	if _, ok := dc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Document.updated_at"`)}
 	}
@@ -166,6 +213,14 @@ func (dc *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		_spec.SetField(document.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := dc.mutation.BlobHash(); ok {
+		_spec.SetField(document.FieldBlobHash, field.TypeString, value)
+		_node.BlobHash = value
+	}
+	if value, ok := dc.mutation.EmbeddingModel(); ok {
+		_spec.SetField(document.FieldEmbeddingModel, field.TypeString, value)
+		_node.EmbeddingModel = value
+	}
 	if value, ok := dc.mutation.UpdatedAt(); ok {
 		_spec.SetField(document.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -274,6 +329,30 @@ func (u *DocumentUpsert) UpdateDescription() *DocumentUpsert {
 	return u
 }
 
+// SetBlobHash sets the "blob_hash" field.
+func (u *DocumentUpsert) SetBlobHash(v string) *DocumentUpsert {
+	u.Set(document.FieldBlobHash, v)
+	return u
+}
+
+// UpdateBlobHash sets the "blob_hash" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateBlobHash() *DocumentUpsert {
+	u.SetExcluded(document.FieldBlobHash)
+	return u
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *DocumentUpsert) SetEmbeddingModel(v string) *DocumentUpsert {
+	u.Set(document.FieldEmbeddingModel, v)
+	return u
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *DocumentUpsert) UpdateEmbeddingModel() *DocumentUpsert {
+	u.SetExcluded(document.FieldEmbeddingModel)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *DocumentUpsert) SetUpdatedAt(v time.Time) *DocumentUpsert {
 	u.Set(document.FieldUpdatedAt, v)
@@ -402,6 +481,34 @@ func (u *DocumentUpsertOne) UpdateDescription() *DocumentUpsertOne {
 	})
 }
 
+// SetBlobHash sets the "blob_hash" field.
+func (u *DocumentUpsertOne) SetBlobHash(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetBlobHash(v)
+	})
+}
+
+// UpdateBlobHash sets the "blob_hash" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateBlobHash() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateBlobHash()
+	})
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *DocumentUpsertOne) SetEmbeddingModel(v string) *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetEmbeddingModel(v)
+	})
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *DocumentUpsertOne) UpdateEmbeddingModel() *DocumentUpsertOne {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateEmbeddingModel()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *DocumentUpsertOne) SetUpdatedAt(v time.Time) *DocumentUpsertOne {
 	return u.Update(func(s *DocumentUpsert) {
@@ -482,6 +589,7 @@ func (dcb *DocumentCreateBulk) Save(ctx context.Context) ([]*Document, error) {
 	for i := range dcb.builders {
 		func(i int, root context.Context) {
 			builder := dcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*DocumentMutation)
 				if !ok {
@@ -699,6 +807,34 @@ func (u *DocumentUpsertBulk) UpdateDescription() *DocumentUpsertBulk {
 	})
 }
 
+// SetBlobHash sets the "blob_hash" field.
+func (u *DocumentUpsertBulk) SetBlobHash(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetBlobHash(v)
+	})
+}
+
+// UpdateBlobHash sets the "blob_hash" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateBlobHash() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateBlobHash()
+	})
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (u *DocumentUpsertBulk) SetEmbeddingModel(v string) *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.SetEmbeddingModel(v)
+	})
+}
+
+// UpdateEmbeddingModel sets the "embedding_model" field to the value that was provided on create.
+func (u *DocumentUpsertBulk) UpdateEmbeddingModel() *DocumentUpsertBulk {
+	return u.Update(func(s *DocumentUpsert) {
+		s.UpdateEmbeddingModel()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *DocumentUpsertBulk) SetUpdatedAt(v time.Time) *DocumentUpsertBulk {
 	return u.Update(func(s *DocumentUpsert) {
@@ -85,6 +85,34 @@ func (du *DocumentUpdate) SetNillableDescription(s *string) *DocumentUpdate {
 	return du
 }
 
+// SetBlobHash sets the "blob_hash" field.
+func (du *DocumentUpdate) SetBlobHash(s string) *DocumentUpdate {
+	du.mutation.SetBlobHash(s)
+	return du
+}
+
+// SetNillableBlobHash sets the "blob_hash" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableBlobHash(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetBlobHash(*s)
+	}
+	return du
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (du *DocumentUpdate) SetEmbeddingModel(s string) *DocumentUpdate {
+	du.mutation.SetEmbeddingModel(s)
+	return du
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableEmbeddingModel(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetEmbeddingModel(*s)
+	}
+	return du
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (du *DocumentUpdate) SetUpdatedAt(t time.Time) *DocumentUpdate {
 	du.mutation.SetUpdatedAt(t)
@@ -166,6 +194,12 @@ func (du *DocumentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.Description(); ok {
 		_spec.SetField(document.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := du.mutation.BlobHash(); ok {
+		_spec.SetField(document.FieldBlobHash, field.TypeString, value)
+	}
+	if value, ok := du.mutation.EmbeddingModel(); ok {
+		_spec.SetField(document.FieldEmbeddingModel, field.TypeString, value)
+	}
 	if value, ok := du.mutation.UpdatedAt(); ok {
 		_spec.SetField(document.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -248,6 +282,34 @@ func (duo *DocumentUpdateOne) SetNillableDescription(s *string) *DocumentUpdateO
 	return duo
 }
 
+// SetBlobHash sets the "blob_hash" field.
+func (duo *DocumentUpdateOne) SetBlobHash(s string) *DocumentUpdateOne {
+	duo.mutation.SetBlobHash(s)
+	return duo
+}
+
+// SetNillableBlobHash sets the "blob_hash" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableBlobHash(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetBlobHash(*s)
+	}
+	return duo
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (duo *DocumentUpdateOne) SetEmbeddingModel(s string) *DocumentUpdateOne {
+	duo.mutation.SetEmbeddingModel(s)
+	return duo
+}
+
+// SetNillableEmbeddingModel sets the "embedding_model" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableEmbeddingModel(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetEmbeddingModel(*s)
+	}
+	return duo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (duo *DocumentUpdateOne) SetUpdatedAt(t time.Time) *DocumentUpdateOne {
 	duo.mutation.SetUpdatedAt(t)
@@ -359,6 +421,12 @@ func (duo *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 	if value, ok := duo.mutation.Description(); ok {
 		_spec.SetField(document.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := duo.mutation.BlobHash(); ok {
+		_spec.SetField(document.FieldBlobHash, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.EmbeddingModel(); ok {
+		_spec.SetField(document.FieldEmbeddingModel, field.TypeString, value)
+	}
 	if value, ok := duo.mutation.UpdatedAt(); ok {
 		_spec.SetField(document.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -12,7 +12,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/blockchange"
 	"github.com/nakamasato/aicoder/ent/document"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/review"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +77,11 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			document.Table: document.ValidColumn,
+			applyrun.Table:    applyrun.ValidColumn,
+			blockchange.Table: blockchange.ValidColumn,
+			document.Table:    document.ValidColumn,
+			plan.Table:        plan.ValidColumn,
+			review.Table:      review.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
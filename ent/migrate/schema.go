@@ -9,6 +9,64 @@ import (
 )
 
 var (
+	// ApplyRunsColumns holds the columns for the "apply_runs" table.
+	ApplyRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "dryrun", Type: field.TypeBool},
+		{Name: "changes", Type: field.TypeInt},
+		{Name: "succeeded", Type: field.TypeBool},
+		{Name: "error", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "plan_id", Type: field.TypeString},
+	}
+	// ApplyRunsTable holds the schema information for the "apply_runs" table.
+	ApplyRunsTable = &schema.Table{
+		Name:       "apply_runs",
+		Columns:    ApplyRunsColumns,
+		PrimaryKey: []*schema.Column{ApplyRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "apply_runs_plans_apply_runs",
+				Columns:    []*schema.Column{ApplyRunsColumns[6]},
+				RefColumns: []*schema.Column{PlansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// BlockChangesColumns holds the columns for the "block_changes" table.
+	BlockChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "index", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Size: 2147483647},
+		{Name: "target_type", Type: field.TypeString, Size: 2147483647},
+		{Name: "target_name", Type: field.TypeString, Size: 2147483647},
+		{Name: "new_content", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "new_comment", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "decision", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "plan_id", Type: field.TypeString},
+	}
+	// BlockChangesTable holds the schema information for the "block_changes" table.
+	BlockChangesTable = &schema.Table{
+		Name:       "block_changes",
+		Columns:    BlockChangesColumns,
+		PrimaryKey: []*schema.Column{BlockChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "block_changes_plans_block_changes",
+				Columns:    []*schema.Column{BlockChangesColumns[9]},
+				RefColumns: []*schema.Column{PlansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blockchange_plan_id_index",
+				Unique:  true,
+				Columns: []*schema.Column{BlockChangesColumns[9], BlockChangesColumns[1]},
+			},
+		},
+	}
 	// DocumentsColumns holds the columns for the "documents" table.
 	DocumentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -16,6 +74,8 @@ var (
 		{Name: "context", Type: field.TypeString, Size: 2147483647},
 		{Name: "filepath", Type: field.TypeString, Size: 2147483647},
 		{Name: "description", Type: field.TypeString, Size: 2147483647},
+		{Name: "blob_hash", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "embedding_model", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "embedding", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "vector(1536)"}},
 	}
@@ -28,7 +88,7 @@ var (
 			{
 				Name:    "document_embedding",
 				Unique:  false,
-				Columns: []*schema.Column{DocumentsColumns[6]},
+				Columns: []*schema.Column{DocumentsColumns[8]},
 				Annotation: &entsql.IndexAnnotation{
 					OpClass: "vector_l2_ops",
 					Type:    "hnsw",
@@ -41,11 +101,61 @@ var (
 			},
 		},
 	}
+	// PlansColumns holds the columns for the "plans" table.
+	PlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "repository", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "query", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PlansTable holds the schema information for the "plans" table.
+	PlansTable = &schema.Table{
+		Name:       "plans",
+		Columns:    PlansColumns,
+		PrimaryKey: []*schema.Column{PlansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "plan_repository_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlansColumns[1], PlansColumns[4]},
+			},
+		},
+	}
+	// ReviewsColumns holds the columns for the "reviews" table.
+	ReviewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "approved", Type: field.TypeBool},
+		{Name: "comment", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "plan_id", Type: field.TypeString},
+	}
+	// ReviewsTable holds the schema information for the "reviews" table.
+	ReviewsTable = &schema.Table{
+		Name:       "reviews",
+		Columns:    ReviewsColumns,
+		PrimaryKey: []*schema.Column{ReviewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reviews_plans_reviews",
+				Columns:    []*schema.Column{ReviewsColumns[4]},
+				RefColumns: []*schema.Column{PlansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ApplyRunsTable,
+		BlockChangesTable,
 		DocumentsTable,
+		PlansTable,
+		ReviewsTable,
 	}
 )
 
 func init() {
+	ApplyRunsTable.ForeignKeys[0].RefTable = PlansTable
+	BlockChangesTable.ForeignKeys[0].RefTable = PlansTable
+	ReviewsTable.ForeignKeys[0].RefTable = PlansTable
 }
@@ -11,8 +11,12 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/blockchange"
 	"github.com/nakamasato/aicoder/ent/document"
+	"github.com/nakamasato/aicoder/ent/plan"
 	"github.com/nakamasato/aicoder/ent/predicate"
+	"github.com/nakamasato/aicoder/ent/review"
 	pgvector "github.com/pgvector/pgvector-go"
 )
 
@@ -25,38 +29,44 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDocument = "Document"
+	TypeApplyRun    = "ApplyRun"
+	TypeBlockChange = "BlockChange"
+	TypeDocument    = "Document"
+	TypePlan        = "Plan"
+	TypeReview      = "Review"
 )
 
-// DocumentMutation represents an operation that mutates the Document nodes in the graph.
-type DocumentMutation struct {
+// ApplyRunMutation represents an operation that mutates the ApplyRun nodes in the graph.
+type ApplyRunMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *int64
-	repository    *string
-	context       *string
-	filepath      *string
-	description   *string
-	updated_at    *time.Time
-	embedding     *pgvector.Vector
+	id            *int
+	dryrun        *bool
+	changes       *int
+	addchanges    *int
+	succeeded     *bool
+	error         *string
+	created_at    *time.Time
 	clearedFields map[string]struct{}
+	plan          *string
+	clearedplan   bool
 	done          bool
-	oldValue      func(context.Context) (*Document, error)
-	predicates    []predicate.Document
+	oldValue      func(context.Context) (*ApplyRun, error)
+	predicates    []predicate.ApplyRun
 }
 
-var _ ent.Mutation = (*DocumentMutation)(nil)
+var _ ent.Mutation = (*ApplyRunMutation)(nil)
 
-// documentOption allows management of the mutation configuration using functional options.
-type documentOption func(*DocumentMutation)
+// applyrunOption allows management of the mutation configuration using functional options.
+type applyrunOption func(*ApplyRunMutation)
 
-// newDocumentMutation creates new mutation for the Document entity.
-func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
-	m := &DocumentMutation{
+// newApplyRunMutation creates new mutation for the ApplyRun entity.
+func newApplyRunMutation(c config, op Op, opts ...applyrunOption) *ApplyRunMutation {
+	m := &ApplyRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDocument,
+		typ:           TypeApplyRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -65,20 +75,20 @@ func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutat
 	return m
 }
 
-// withDocumentID sets the ID field of the mutation.
-func withDocumentID(id int64) documentOption {
-	return func(m *DocumentMutation) {
+// withApplyRunID sets the ID field of the mutation.
+func withApplyRunID(id int) applyrunOption {
+	return func(m *ApplyRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Document
+			value *ApplyRun
 		)
-		m.oldValue = func(ctx context.Context) (*Document, error) {
+		m.oldValue = func(ctx context.Context) (*ApplyRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Document.Get(ctx, id)
+					value, err = m.Client().ApplyRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -87,10 +97,10 @@ func withDocumentID(id int64) documentOption {
 	}
 }
 
-// withDocument sets the old Document of the mutation.
-func withDocument(node *Document) documentOption {
-	return func(m *DocumentMutation) {
-		m.oldValue = func(context.Context) (*Document, error) {
+// withApplyRun sets the old ApplyRun of the mutation.
+func withApplyRun(node *ApplyRun) applyrunOption {
+	return func(m *ApplyRunMutation) {
+		m.oldValue = func(context.Context) (*ApplyRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -99,7 +109,7 @@ func withDocument(node *Document) documentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DocumentMutation) Client() *Client {
+func (m ApplyRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -107,7 +117,7 @@ func (m DocumentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DocumentMutation) Tx() (*Tx, error) {
+func (m ApplyRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -116,15 +126,9 @@ func (m DocumentMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Document entities.
-func (m *DocumentMutation) SetID(id int64) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DocumentMutation) ID() (id int64, exists bool) {
+func (m *ApplyRunMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -135,246 +139,293 @@ func (m *DocumentMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DocumentMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *ApplyRunMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int64{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ApplyRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRepository sets the "repository" field.
-func (m *DocumentMutation) SetRepository(s string) {
-	m.repository = &s
+// SetPlanID sets the "plan_id" field.
+func (m *ApplyRunMutation) SetPlanID(s string) {
+	m.plan = &s
 }
 
-// Repository returns the value of the "repository" field in the mutation.
-func (m *DocumentMutation) Repository() (r string, exists bool) {
-	v := m.repository
+// PlanID returns the value of the "plan_id" field in the mutation.
+func (m *ApplyRunMutation) PlanID() (r string, exists bool) {
+	v := m.plan
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRepository returns the old "repository" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldPlanID returns the old "plan_id" field's value of the ApplyRun entity.
+// If the ApplyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldRepository(ctx context.Context) (v string, err error) {
+func (m *ApplyRunMutation) OldPlanID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRepository is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRepository requires an ID field in the mutation")
+		return v, errors.New("OldPlanID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRepository: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
 	}
-	return oldValue.Repository, nil
+	return oldValue.PlanID, nil
 }
 
-// ResetRepository resets all changes to the "repository" field.
-func (m *DocumentMutation) ResetRepository() {
-	m.repository = nil
+// ResetPlanID resets all changes to the "plan_id" field.
+func (m *ApplyRunMutation) ResetPlanID() {
+	m.plan = nil
 }
 
-// SetContext sets the "context" field.
-func (m *DocumentMutation) SetContext(s string) {
-	m.context = &s
+// SetDryrun sets the "dryrun" field.
+func (m *ApplyRunMutation) SetDryrun(b bool) {
+	m.dryrun = &b
 }
 
-// Context returns the value of the "context" field in the mutation.
-func (m *DocumentMutation) Context() (r string, exists bool) {
-	v := m.context
+// Dryrun returns the value of the "dryrun" field in the mutation.
+func (m *ApplyRunMutation) Dryrun() (r bool, exists bool) {
+	v := m.dryrun
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContext returns the old "context" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldDryrun returns the old "dryrun" field's value of the ApplyRun entity.
+// If the ApplyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldContext(ctx context.Context) (v string, err error) {
+func (m *ApplyRunMutation) OldDryrun(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContext is only allowed on UpdateOne operations")
+		return v, errors.New("OldDryrun is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContext requires an ID field in the mutation")
+		return v, errors.New("OldDryrun requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContext: %w", err)
+		return v, fmt.Errorf("querying old value for OldDryrun: %w", err)
 	}
-	return oldValue.Context, nil
+	return oldValue.Dryrun, nil
 }
 
-// ResetContext resets all changes to the "context" field.
-func (m *DocumentMutation) ResetContext() {
-	m.context = nil
+// ResetDryrun resets all changes to the "dryrun" field.
+func (m *ApplyRunMutation) ResetDryrun() {
+	m.dryrun = nil
 }
 
-// SetFilepath sets the "filepath" field.
-func (m *DocumentMutation) SetFilepath(s string) {
-	m.filepath = &s
+// SetChanges sets the "changes" field.
+func (m *ApplyRunMutation) SetChanges(i int) {
+	m.changes = &i
+	m.addchanges = nil
 }
 
-// Filepath returns the value of the "filepath" field in the mutation.
-func (m *DocumentMutation) Filepath() (r string, exists bool) {
-	v := m.filepath
+// Changes returns the value of the "changes" field in the mutation.
+func (m *ApplyRunMutation) Changes() (r int, exists bool) {
+	v := m.changes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilepath returns the old "filepath" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldChanges returns the old "changes" field's value of the ApplyRun entity.
+// If the ApplyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldFilepath(ctx context.Context) (v string, err error) {
+func (m *ApplyRunMutation) OldChanges(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilepath is only allowed on UpdateOne operations")
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilepath requires an ID field in the mutation")
+		return v, errors.New("OldChanges requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilepath: %w", err)
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
 	}
-	return oldValue.Filepath, nil
+	return oldValue.Changes, nil
 }
 
-// ResetFilepath resets all changes to the "filepath" field.
-func (m *DocumentMutation) ResetFilepath() {
-	m.filepath = nil
+// AddChanges adds i to the "changes" field.
+func (m *ApplyRunMutation) AddChanges(i int) {
+	if m.addchanges != nil {
+		*m.addchanges += i
+	} else {
+		m.addchanges = &i
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *DocumentMutation) SetDescription(s string) {
-	m.description = &s
+// AddedChanges returns the value that was added to the "changes" field in this mutation.
+func (m *ApplyRunMutation) AddedChanges() (r int, exists bool) {
+	v := m.addchanges
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *DocumentMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetChanges resets all changes to the "changes" field.
+func (m *ApplyRunMutation) ResetChanges() {
+	m.changes = nil
+	m.addchanges = nil
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (m *ApplyRunMutation) SetSucceeded(b bool) {
+	m.succeeded = &b
+}
+
+// Succeeded returns the value of the "succeeded" field in the mutation.
+func (m *ApplyRunMutation) Succeeded() (r bool, exists bool) {
+	v := m.succeeded
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldSucceeded returns the old "succeeded" field's value of the ApplyRun entity.
+// If the ApplyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ApplyRunMutation) OldSucceeded(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSucceeded is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSucceeded requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSucceeded: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Succeeded, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *DocumentMutation) ResetDescription() {
-	m.description = nil
+// ResetSucceeded resets all changes to the "succeeded" field.
+func (m *ApplyRunMutation) ResetSucceeded() {
+	m.succeeded = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetError sets the "error" field.
+func (m *ApplyRunMutation) SetError(s string) {
+	m.error = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Error returns the value of the "error" field in the mutation.
+func (m *ApplyRunMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the ApplyRun entity.
+// If the ApplyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ApplyRunMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Error, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DocumentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetError resets all changes to the "error" field.
+func (m *ApplyRunMutation) ResetError() {
+	m.error = nil
 }
 
-// SetEmbedding sets the "embedding" field.
-func (m *DocumentMutation) SetEmbedding(pg pgvector.Vector) {
-	m.embedding = &pg
+// SetCreatedAt sets the "created_at" field.
+func (m *ApplyRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Embedding returns the value of the "embedding" field in the mutation.
-func (m *DocumentMutation) Embedding() (r pgvector.Vector, exists bool) {
-	v := m.embedding
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ApplyRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmbedding returns the old "embedding" field's value of the Document entity.
-// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ApplyRun entity.
+// If the ApplyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+func (m *ApplyRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Embedding, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEmbedding resets all changes to the "embedding" field.
-func (m *DocumentMutation) ResetEmbedding() {
-	m.embedding = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ApplyRunMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the DocumentMutation builder.
-func (m *DocumentMutation) Where(ps ...predicate.Document) {
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (m *ApplyRunMutation) ClearPlan() {
+	m.clearedplan = true
+	m.clearedFields[applyrun.FieldPlanID] = struct{}{}
+}
+
+// PlanCleared reports if the "plan" edge to the Plan entity was cleared.
+func (m *ApplyRunMutation) PlanCleared() bool {
+	return m.clearedplan
+}
+
+// PlanIDs returns the "plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlanID instead. It exists only for internal usage by the builders.
+func (m *ApplyRunMutation) PlanIDs() (ids []string) {
+	if id := m.plan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlan resets all changes to the "plan" edge.
+func (m *ApplyRunMutation) ResetPlan() {
+	m.plan = nil
+	m.clearedplan = false
+}
+
+// Where appends a list predicates to the ApplyRunMutation builder.
+func (m *ApplyRunMutation) Where(ps ...predicate.ApplyRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ApplyRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Document, len(ps))
+func (m *ApplyRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ApplyRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -382,42 +433,42 @@ func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DocumentMutation) Op() Op {
+func (m *ApplyRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DocumentMutation) SetOp(op Op) {
+func (m *ApplyRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Document).
-func (m *DocumentMutation) Type() string {
+// Type returns the node type of this mutation (ApplyRun).
+func (m *ApplyRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DocumentMutation) Fields() []string {
+func (m *ApplyRunMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.repository != nil {
-		fields = append(fields, document.FieldRepository)
+	if m.plan != nil {
+		fields = append(fields, applyrun.FieldPlanID)
 	}
-	if m.context != nil {
-		fields = append(fields, document.FieldContext)
+	if m.dryrun != nil {
+		fields = append(fields, applyrun.FieldDryrun)
 	}
-	if m.filepath != nil {
-		fields = append(fields, document.FieldFilepath)
+	if m.changes != nil {
+		fields = append(fields, applyrun.FieldChanges)
 	}
-	if m.description != nil {
-		fields = append(fields, document.FieldDescription)
+	if m.succeeded != nil {
+		fields = append(fields, applyrun.FieldSucceeded)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, document.FieldUpdatedAt)
+	if m.error != nil {
+		fields = append(fields, applyrun.FieldError)
 	}
-	if m.embedding != nil {
-		fields = append(fields, document.FieldEmbedding)
+	if m.created_at != nil {
+		fields = append(fields, applyrun.FieldCreatedAt)
 	}
 	return fields
 }
@@ -425,20 +476,20 @@ func (m *DocumentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
+func (m *ApplyRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case document.FieldRepository:
-		return m.Repository()
-	case document.FieldContext:
-		return m.Context()
-	case document.FieldFilepath:
-		return m.Filepath()
-	case document.FieldDescription:
-		return m.Description()
-	case document.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case document.FieldEmbedding:
-		return m.Embedding()
+	case applyrun.FieldPlanID:
+		return m.PlanID()
+	case applyrun.FieldDryrun:
+		return m.Dryrun()
+	case applyrun.FieldChanges:
+		return m.Changes()
+	case applyrun.FieldSucceeded:
+		return m.Succeeded()
+	case applyrun.FieldError:
+		return m.Error()
+	case applyrun.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -446,163 +497,1762 @@ func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApplyRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case document.FieldRepository:
-		return m.OldRepository(ctx)
-	case document.FieldContext:
-		return m.OldContext(ctx)
-	case document.FieldFilepath:
-		return m.OldFilepath(ctx)
-	case document.FieldDescription:
-		return m.OldDescription(ctx)
-	case document.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case document.FieldEmbedding:
-		return m.OldEmbedding(ctx)
+	case applyrun.FieldPlanID:
+		return m.OldPlanID(ctx)
+	case applyrun.FieldDryrun:
+		return m.OldDryrun(ctx)
+	case applyrun.FieldChanges:
+		return m.OldChanges(ctx)
+	case applyrun.FieldSucceeded:
+		return m.OldSucceeded(ctx)
+	case applyrun.FieldError:
+		return m.OldError(ctx)
+	case applyrun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Document field %s", name)
+	return nil, fmt.Errorf("unknown ApplyRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentMutation) SetField(name string, value ent.Value) error {
+func (m *ApplyRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case document.FieldRepository:
+	case applyrun.FieldPlanID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRepository(v)
+		m.SetPlanID(v)
 		return nil
-	case document.FieldContext:
-		v, ok := value.(string)
+	case applyrun.FieldDryrun:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContext(v)
+		m.SetDryrun(v)
 		return nil
-	case document.FieldFilepath:
-		v, ok := value.(string)
+	case applyrun.FieldChanges:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilepath(v)
+		m.SetChanges(v)
 		return nil
-	case document.FieldDescription:
-		v, ok := value.(string)
+	case applyrun.FieldSucceeded:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetSucceeded(v)
 		return nil
-	case document.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case applyrun.FieldError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetError(v)
 		return nil
-	case document.FieldEmbedding:
-		v, ok := value.(pgvector.Vector)
+	case applyrun.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmbedding(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Document field %s", name)
+	return fmt.Errorf("unknown ApplyRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DocumentMutation) AddedFields() []string {
-	return nil
+func (m *ApplyRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addchanges != nil {
+		fields = append(fields, applyrun.FieldChanges)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ApplyRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case applyrun.FieldChanges:
+		return m.AddedChanges()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentMutation) AddField(name string, value ent.Value) error {
+func (m *ApplyRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case applyrun.FieldChanges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChanges(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Document numeric field %s", name)
+	return fmt.Errorf("unknown ApplyRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DocumentMutation) ClearedFields() []string {
+func (m *ApplyRunMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DocumentMutation) FieldCleared(name string) bool {
+func (m *ApplyRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DocumentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Document nullable field %s", name)
+func (m *ApplyRunMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ApplyRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DocumentMutation) ResetField(name string) error {
+func (m *ApplyRunMutation) ResetField(name string) error {
 	switch name {
-	case document.FieldRepository:
-		m.ResetRepository()
+	case applyrun.FieldPlanID:
+		m.ResetPlanID()
 		return nil
-	case document.FieldContext:
-		m.ResetContext()
+	case applyrun.FieldDryrun:
+		m.ResetDryrun()
 		return nil
-	case document.FieldFilepath:
-		m.ResetFilepath()
+	case applyrun.FieldChanges:
+		m.ResetChanges()
 		return nil
-	case document.FieldDescription:
-		m.ResetDescription()
+	case applyrun.FieldSucceeded:
+		m.ResetSucceeded()
 		return nil
-	case document.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case applyrun.FieldError:
+		m.ResetError()
 		return nil
-	case document.FieldEmbedding:
-		m.ResetEmbedding()
+	case applyrun.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Document field %s", name)
+	return fmt.Errorf("unknown ApplyRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DocumentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ApplyRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.plan != nil {
+		edges = append(edges, applyrun.EdgePlan)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
+func (m *ApplyRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case applyrun.EdgePlan:
+		if id := m.plan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DocumentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ApplyRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
+func (m *ApplyRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ApplyRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplan {
+		edges = append(edges, applyrun.EdgePlan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ApplyRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case applyrun.EdgePlan:
+		return m.clearedplan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ApplyRunMutation) ClearEdge(name string) error {
+	switch name {
+	case applyrun.EdgePlan:
+		m.ClearPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown ApplyRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ApplyRunMutation) ResetEdge(name string) error {
+	switch name {
+	case applyrun.EdgePlan:
+		m.ResetPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown ApplyRun edge %s", name)
+}
+
+// BlockChangeMutation represents an operation that mutates the BlockChange nodes in the graph.
+type BlockChangeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	index         *int
+	addindex      *int
+	action        *string
+	_path         *string
+	target_type   *string
+	target_name   *string
+	new_content   *string
+	new_comment   *string
+	decision      *string
+	clearedFields map[string]struct{}
+	plan          *string
+	clearedplan   bool
+	done          bool
+	oldValue      func(context.Context) (*BlockChange, error)
+	predicates    []predicate.BlockChange
+}
+
+var _ ent.Mutation = (*BlockChangeMutation)(nil)
+
+// blockchangeOption allows management of the mutation configuration using functional options.
+type blockchangeOption func(*BlockChangeMutation)
+
+// newBlockChangeMutation creates new mutation for the BlockChange entity.
+func newBlockChangeMutation(c config, op Op, opts ...blockchangeOption) *BlockChangeMutation {
+	m := &BlockChangeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBlockChange,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBlockChangeID sets the ID field of the mutation.
+func withBlockChangeID(id int) blockchangeOption {
+	return func(m *BlockChangeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BlockChange
+		)
+		m.oldValue = func(ctx context.Context) (*BlockChange, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BlockChange.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBlockChange sets the old BlockChange of the mutation.
+func withBlockChange(node *BlockChange) blockchangeOption {
+	return func(m *BlockChangeMutation) {
+		m.oldValue = func(context.Context) (*BlockChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BlockChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BlockChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BlockChangeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BlockChangeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BlockChange.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPlanID sets the "plan_id" field.
+func (m *BlockChangeMutation) SetPlanID(s string) {
+	m.plan = &s
+}
+
+// PlanID returns the value of the "plan_id" field in the mutation.
+func (m *BlockChangeMutation) PlanID() (r string, exists bool) {
+	v := m.plan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlanID returns the old "plan_id" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldPlanID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
+	}
+	return oldValue.PlanID, nil
+}
+
+// ResetPlanID resets all changes to the "plan_id" field.
+func (m *BlockChangeMutation) ResetPlanID() {
+	m.plan = nil
+}
+
+// SetIndex sets the "index" field.
+func (m *BlockChangeMutation) SetIndex(i int) {
+	m.index = &i
+	m.addindex = nil
+}
+
+// Index returns the value of the "index" field in the mutation.
+func (m *BlockChangeMutation) Index() (r int, exists bool) {
+	v := m.index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndex returns the old "index" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+	}
+	return oldValue.Index, nil
+}
+
+// AddIndex adds i to the "index" field.
+func (m *BlockChangeMutation) AddIndex(i int) {
+	if m.addindex != nil {
+		*m.addindex += i
+	} else {
+		m.addindex = &i
+	}
+}
+
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *BlockChangeMutation) AddedIndex() (r int, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIndex resets all changes to the "index" field.
+func (m *BlockChangeMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+}
+
+// SetAction sets the "action" field.
+func (m *BlockChangeMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *BlockChangeMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *BlockChangeMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetPath sets the "path" field.
+func (m *BlockChangeMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *BlockChangeMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *BlockChangeMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetTargetType sets the "target_type" field.
+func (m *BlockChangeMutation) SetTargetType(s string) {
+	m.target_type = &s
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *BlockChangeMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldTargetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
+}
+
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *BlockChangeMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetTargetName sets the "target_name" field.
+func (m *BlockChangeMutation) SetTargetName(s string) {
+	m.target_name = &s
+}
+
+// TargetName returns the value of the "target_name" field in the mutation.
+func (m *BlockChangeMutation) TargetName() (r string, exists bool) {
+	v := m.target_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetName returns the old "target_name" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldTargetName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetName: %w", err)
+	}
+	return oldValue.TargetName, nil
+}
+
+// ResetTargetName resets all changes to the "target_name" field.
+func (m *BlockChangeMutation) ResetTargetName() {
+	m.target_name = nil
+}
+
+// SetNewContent sets the "new_content" field.
+func (m *BlockChangeMutation) SetNewContent(s string) {
+	m.new_content = &s
+}
+
+// NewContent returns the value of the "new_content" field in the mutation.
+func (m *BlockChangeMutation) NewContent() (r string, exists bool) {
+	v := m.new_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewContent returns the old "new_content" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldNewContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewContent: %w", err)
+	}
+	return oldValue.NewContent, nil
+}
+
+// ResetNewContent resets all changes to the "new_content" field.
+func (m *BlockChangeMutation) ResetNewContent() {
+	m.new_content = nil
+}
+
+// SetNewComment sets the "new_comment" field.
+func (m *BlockChangeMutation) SetNewComment(s string) {
+	m.new_comment = &s
+}
+
+// NewComment returns the value of the "new_comment" field in the mutation.
+func (m *BlockChangeMutation) NewComment() (r string, exists bool) {
+	v := m.new_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewComment returns the old "new_comment" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldNewComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewComment: %w", err)
+	}
+	return oldValue.NewComment, nil
+}
+
+// ResetNewComment resets all changes to the "new_comment" field.
+func (m *BlockChangeMutation) ResetNewComment() {
+	m.new_comment = nil
+}
+
+// SetDecision sets the "decision" field.
+func (m *BlockChangeMutation) SetDecision(s string) {
+	m.decision = &s
+}
+
+// Decision returns the value of the "decision" field in the mutation.
+func (m *BlockChangeMutation) Decision() (r string, exists bool) {
+	v := m.decision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecision returns the old "decision" field's value of the BlockChange entity.
+// If the BlockChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockChangeMutation) OldDecision(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecision: %w", err)
+	}
+	return oldValue.Decision, nil
+}
+
+// ResetDecision resets all changes to the "decision" field.
+func (m *BlockChangeMutation) ResetDecision() {
+	m.decision = nil
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (m *BlockChangeMutation) ClearPlan() {
+	m.clearedplan = true
+	m.clearedFields[blockchange.FieldPlanID] = struct{}{}
+}
+
+// PlanCleared reports if the "plan" edge to the Plan entity was cleared.
+func (m *BlockChangeMutation) PlanCleared() bool {
+	return m.clearedplan
+}
+
+// PlanIDs returns the "plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlanID instead. It exists only for internal usage by the builders.
+func (m *BlockChangeMutation) PlanIDs() (ids []string) {
+	if id := m.plan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlan resets all changes to the "plan" edge.
+func (m *BlockChangeMutation) ResetPlan() {
+	m.plan = nil
+	m.clearedplan = false
+}
+
+// Where appends a list predicates to the BlockChangeMutation builder.
+func (m *BlockChangeMutation) Where(ps ...predicate.BlockChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlockChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlockChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BlockChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BlockChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BlockChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BlockChange).
+func (m *BlockChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlockChangeMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.plan != nil {
+		fields = append(fields, blockchange.FieldPlanID)
+	}
+	if m.index != nil {
+		fields = append(fields, blockchange.FieldIndex)
+	}
+	if m.action != nil {
+		fields = append(fields, blockchange.FieldAction)
+	}
+	if m._path != nil {
+		fields = append(fields, blockchange.FieldPath)
+	}
+	if m.target_type != nil {
+		fields = append(fields, blockchange.FieldTargetType)
+	}
+	if m.target_name != nil {
+		fields = append(fields, blockchange.FieldTargetName)
+	}
+	if m.new_content != nil {
+		fields = append(fields, blockchange.FieldNewContent)
+	}
+	if m.new_comment != nil {
+		fields = append(fields, blockchange.FieldNewComment)
+	}
+	if m.decision != nil {
+		fields = append(fields, blockchange.FieldDecision)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlockChangeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blockchange.FieldPlanID:
+		return m.PlanID()
+	case blockchange.FieldIndex:
+		return m.Index()
+	case blockchange.FieldAction:
+		return m.Action()
+	case blockchange.FieldPath:
+		return m.Path()
+	case blockchange.FieldTargetType:
+		return m.TargetType()
+	case blockchange.FieldTargetName:
+		return m.TargetName()
+	case blockchange.FieldNewContent:
+		return m.NewContent()
+	case blockchange.FieldNewComment:
+		return m.NewComment()
+	case blockchange.FieldDecision:
+		return m.Decision()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlockChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blockchange.FieldPlanID:
+		return m.OldPlanID(ctx)
+	case blockchange.FieldIndex:
+		return m.OldIndex(ctx)
+	case blockchange.FieldAction:
+		return m.OldAction(ctx)
+	case blockchange.FieldPath:
+		return m.OldPath(ctx)
+	case blockchange.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case blockchange.FieldTargetName:
+		return m.OldTargetName(ctx)
+	case blockchange.FieldNewContent:
+		return m.OldNewContent(ctx)
+	case blockchange.FieldNewComment:
+		return m.OldNewComment(ctx)
+	case blockchange.FieldDecision:
+		return m.OldDecision(ctx)
+	}
+	return nil, fmt.Errorf("unknown BlockChange field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockChangeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blockchange.FieldPlanID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlanID(v)
+		return nil
+	case blockchange.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case blockchange.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case blockchange.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case blockchange.FieldTargetType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case blockchange.FieldTargetName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetName(v)
+		return nil
+	case blockchange.FieldNewContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewContent(v)
+		return nil
+	case blockchange.FieldNewComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewComment(v)
+		return nil
+	case blockchange.FieldDecision:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BlockChange field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlockChangeMutation) AddedFields() []string {
+	var fields []string
+	if m.addindex != nil {
+		fields = append(fields, blockchange.FieldIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlockChangeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blockchange.FieldIndex:
+		return m.AddedIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockChangeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case blockchange.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BlockChange numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlockChangeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlockChangeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlockChangeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BlockChange nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlockChangeMutation) ResetField(name string) error {
+	switch name {
+	case blockchange.FieldPlanID:
+		m.ResetPlanID()
+		return nil
+	case blockchange.FieldIndex:
+		m.ResetIndex()
+		return nil
+	case blockchange.FieldAction:
+		m.ResetAction()
+		return nil
+	case blockchange.FieldPath:
+		m.ResetPath()
+		return nil
+	case blockchange.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case blockchange.FieldTargetName:
+		m.ResetTargetName()
+		return nil
+	case blockchange.FieldNewContent:
+		m.ResetNewContent()
+		return nil
+	case blockchange.FieldNewComment:
+		m.ResetNewComment()
+		return nil
+	case blockchange.FieldDecision:
+		m.ResetDecision()
+		return nil
+	}
+	return fmt.Errorf("unknown BlockChange field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlockChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.plan != nil {
+		edges = append(edges, blockchange.EdgePlan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlockChangeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case blockchange.EdgePlan:
+		if id := m.plan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlockChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlockChangeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlockChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplan {
+		edges = append(edges, blockchange.EdgePlan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlockChangeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case blockchange.EdgePlan:
+		return m.clearedplan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlockChangeMutation) ClearEdge(name string) error {
+	switch name {
+	case blockchange.EdgePlan:
+		m.ClearPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown BlockChange unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlockChangeMutation) ResetEdge(name string) error {
+	switch name {
+	case blockchange.EdgePlan:
+		m.ResetPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown BlockChange edge %s", name)
+}
+
+// DocumentMutation represents an operation that mutates the Document nodes in the graph.
+type DocumentMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int64
+	repository      *string
+	context         *string
+	filepath        *string
+	description     *string
+	blob_hash       *string
+	embedding_model *string
+	updated_at      *time.Time
+	embedding       *pgvector.Vector
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Document, error)
+	predicates      []predicate.Document
+}
+
+var _ ent.Mutation = (*DocumentMutation)(nil)
+
+// documentOption allows management of the mutation configuration using functional options.
+type documentOption func(*DocumentMutation)
+
+// newDocumentMutation creates new mutation for the Document entity.
+func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
+	m := &DocumentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocument,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocumentID sets the ID field of the mutation.
+func withDocumentID(id int64) documentOption {
+	return func(m *DocumentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Document
+		)
+		m.oldValue = func(ctx context.Context) (*Document, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Document.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocument sets the old Document of the mutation.
+func withDocument(node *Document) documentOption {
+	return func(m *DocumentMutation) {
+		m.oldValue = func(context.Context) (*Document, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocumentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocumentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Document entities.
+func (m *DocumentMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocumentMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocumentMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRepository sets the "repository" field.
+func (m *DocumentMutation) SetRepository(s string) {
+	m.repository = &s
+}
+
+// Repository returns the value of the "repository" field in the mutation.
+func (m *DocumentMutation) Repository() (r string, exists bool) {
+	v := m.repository
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepository returns the old "repository" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldRepository(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepository is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepository requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepository: %w", err)
+	}
+	return oldValue.Repository, nil
+}
+
+// ResetRepository resets all changes to the "repository" field.
+func (m *DocumentMutation) ResetRepository() {
+	m.repository = nil
+}
+
+// SetContext sets the "context" field.
+func (m *DocumentMutation) SetContext(s string) {
+	m.context = &s
+}
+
+// Context returns the value of the "context" field in the mutation.
+func (m *DocumentMutation) Context() (r string, exists bool) {
+	v := m.context
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContext returns the old "context" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldContext(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContext: %w", err)
+	}
+	return oldValue.Context, nil
+}
+
+// ResetContext resets all changes to the "context" field.
+func (m *DocumentMutation) ResetContext() {
+	m.context = nil
+}
+
+// SetFilepath sets the "filepath" field.
+func (m *DocumentMutation) SetFilepath(s string) {
+	m.filepath = &s
+}
+
+// Filepath returns the value of the "filepath" field in the mutation.
+func (m *DocumentMutation) Filepath() (r string, exists bool) {
+	v := m.filepath
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilepath returns the old "filepath" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldFilepath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilepath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilepath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilepath: %w", err)
+	}
+	return oldValue.Filepath, nil
+}
+
+// ResetFilepath resets all changes to the "filepath" field.
+func (m *DocumentMutation) ResetFilepath() {
+	m.filepath = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *DocumentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DocumentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DocumentMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetBlobHash sets the "blob_hash" field.
+func (m *DocumentMutation) SetBlobHash(s string) {
+	m.blob_hash = &s
+}
+
+// BlobHash returns the value of the "blob_hash" field in the mutation.
+func (m *DocumentMutation) BlobHash() (r string, exists bool) {
+	v := m.blob_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlobHash returns the old "blob_hash" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldBlobHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlobHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlobHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlobHash: %w", err)
+	}
+	return oldValue.BlobHash, nil
+}
+
+// ResetBlobHash resets all changes to the "blob_hash" field.
+func (m *DocumentMutation) ResetBlobHash() {
+	m.blob_hash = nil
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (m *DocumentMutation) SetEmbeddingModel(s string) {
+	m.embedding_model = &s
+}
+
+// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
+func (m *DocumentMutation) EmbeddingModel() (r string, exists bool) {
+	v := m.embedding_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingModel returns the old "embedding_model" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldEmbeddingModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+	}
+	return oldValue.EmbeddingModel, nil
+}
+
+// ResetEmbeddingModel resets all changes to the "embedding_model" field.
+func (m *DocumentMutation) ResetEmbeddingModel() {
+	m.embedding_model = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DocumentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *DocumentMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *DocumentMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *DocumentMutation) ResetEmbedding() {
+	m.embedding = nil
+}
+
+// Where appends a list predicates to the DocumentMutation builder.
+func (m *DocumentMutation) Where(ps ...predicate.Document) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Document, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocumentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocumentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Document).
+func (m *DocumentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.repository != nil {
+		fields = append(fields, document.FieldRepository)
+	}
+	if m.context != nil {
+		fields = append(fields, document.FieldContext)
+	}
+	if m.filepath != nil {
+		fields = append(fields, document.FieldFilepath)
+	}
+	if m.description != nil {
+		fields = append(fields, document.FieldDescription)
+	}
+	if m.blob_hash != nil {
+		fields = append(fields, document.FieldBlobHash)
+	}
+	if m.embedding_model != nil {
+		fields = append(fields, document.FieldEmbeddingModel)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, document.FieldUpdatedAt)
+	}
+	if m.embedding != nil {
+		fields = append(fields, document.FieldEmbedding)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case document.FieldRepository:
+		return m.Repository()
+	case document.FieldContext:
+		return m.Context()
+	case document.FieldFilepath:
+		return m.Filepath()
+	case document.FieldDescription:
+		return m.Description()
+	case document.FieldBlobHash:
+		return m.BlobHash()
+	case document.FieldEmbeddingModel:
+		return m.EmbeddingModel()
+	case document.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case document.FieldEmbedding:
+		return m.Embedding()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case document.FieldRepository:
+		return m.OldRepository(ctx)
+	case document.FieldContext:
+		return m.OldContext(ctx)
+	case document.FieldFilepath:
+		return m.OldFilepath(ctx)
+	case document.FieldDescription:
+		return m.OldDescription(ctx)
+	case document.FieldBlobHash:
+		return m.OldBlobHash(ctx)
+	case document.FieldEmbeddingModel:
+		return m.OldEmbeddingModel(ctx)
+	case document.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case document.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	}
+	return nil, fmt.Errorf("unknown Document field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case document.FieldRepository:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepository(v)
+		return nil
+	case document.FieldContext:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContext(v)
+		return nil
+	case document.FieldFilepath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilepath(v)
+		return nil
+	case document.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case document.FieldBlobHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlobHash(v)
+		return nil
+	case document.FieldEmbeddingModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingModel(v)
+		return nil
+	case document.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case document.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Document field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Document numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Document nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocumentMutation) ResetField(name string) error {
+	switch name {
+	case document.FieldRepository:
+		m.ResetRepository()
+		return nil
+	case document.FieldContext:
+		m.ResetContext()
+		return nil
+	case document.FieldFilepath:
+		m.ResetFilepath()
+		return nil
+	case document.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case document.FieldBlobHash:
+		m.ResetBlobHash()
+		return nil
+	case document.FieldEmbeddingModel:
+		m.ResetEmbeddingModel()
+		return nil
+	case document.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case document.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	}
+	return fmt.Errorf("unknown Document field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
@@ -614,18 +2264,1313 @@ func (m *DocumentMutation) ClearedEdges() []string {
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DocumentMutation) EdgeCleared(name string) bool {
+func (m *DocumentMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Document unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Document edge %s", name)
+}
+
+// PlanMutation represents an operation that mutates the Plan nodes in the graph.
+type PlanMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	repository           *string
+	query                *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	block_changes        map[int]struct{}
+	removedblock_changes map[int]struct{}
+	clearedblock_changes bool
+	reviews              map[int]struct{}
+	removedreviews       map[int]struct{}
+	clearedreviews       bool
+	apply_runs           map[int]struct{}
+	removedapply_runs    map[int]struct{}
+	clearedapply_runs    bool
+	done                 bool
+	oldValue             func(context.Context) (*Plan, error)
+	predicates           []predicate.Plan
+}
+
+var _ ent.Mutation = (*PlanMutation)(nil)
+
+// planOption allows management of the mutation configuration using functional options.
+type planOption func(*PlanMutation)
+
+// newPlanMutation creates new mutation for the Plan entity.
+func newPlanMutation(c config, op Op, opts ...planOption) *PlanMutation {
+	m := &PlanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlanID sets the ID field of the mutation.
+func withPlanID(id string) planOption {
+	return func(m *PlanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Plan
+		)
+		m.oldValue = func(ctx context.Context) (*Plan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Plan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlan sets the old Plan of the mutation.
+func withPlan(node *Plan) planOption {
+	return func(m *PlanMutation) {
+		m.oldValue = func(context.Context) (*Plan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Plan entities.
+func (m *PlanMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlanMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlanMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Plan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRepository sets the "repository" field.
+func (m *PlanMutation) SetRepository(s string) {
+	m.repository = &s
+}
+
+// Repository returns the value of the "repository" field in the mutation.
+func (m *PlanMutation) Repository() (r string, exists bool) {
+	v := m.repository
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepository returns the old "repository" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldRepository(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepository is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepository requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepository: %w", err)
+	}
+	return oldValue.Repository, nil
+}
+
+// ResetRepository resets all changes to the "repository" field.
+func (m *PlanMutation) ResetRepository() {
+	m.repository = nil
+}
+
+// SetQuery sets the "query" field.
+func (m *PlanMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *PlanMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *PlanMutation) ResetQuery() {
+	m.query = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlanMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlanMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlanMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddBlockChangeIDs adds the "block_changes" edge to the BlockChange entity by ids.
+func (m *PlanMutation) AddBlockChangeIDs(ids ...int) {
+	if m.block_changes == nil {
+		m.block_changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.block_changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockChanges clears the "block_changes" edge to the BlockChange entity.
+func (m *PlanMutation) ClearBlockChanges() {
+	m.clearedblock_changes = true
+}
+
+// BlockChangesCleared reports if the "block_changes" edge to the BlockChange entity was cleared.
+func (m *PlanMutation) BlockChangesCleared() bool {
+	return m.clearedblock_changes
+}
+
+// RemoveBlockChangeIDs removes the "block_changes" edge to the BlockChange entity by IDs.
+func (m *PlanMutation) RemoveBlockChangeIDs(ids ...int) {
+	if m.removedblock_changes == nil {
+		m.removedblock_changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.block_changes, ids[i])
+		m.removedblock_changes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockChanges returns the removed IDs of the "block_changes" edge to the BlockChange entity.
+func (m *PlanMutation) RemovedBlockChangesIDs() (ids []int) {
+	for id := range m.removedblock_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockChangesIDs returns the "block_changes" edge IDs in the mutation.
+func (m *PlanMutation) BlockChangesIDs() (ids []int) {
+	for id := range m.block_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockChanges resets all changes to the "block_changes" edge.
+func (m *PlanMutation) ResetBlockChanges() {
+	m.block_changes = nil
+	m.clearedblock_changes = false
+	m.removedblock_changes = nil
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by ids.
+func (m *PlanMutation) AddReviewIDs(ids ...int) {
+	if m.reviews == nil {
+		m.reviews = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reviews[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReviews clears the "reviews" edge to the Review entity.
+func (m *PlanMutation) ClearReviews() {
+	m.clearedreviews = true
+}
+
+// ReviewsCleared reports if the "reviews" edge to the Review entity was cleared.
+func (m *PlanMutation) ReviewsCleared() bool {
+	return m.clearedreviews
+}
+
+// RemoveReviewIDs removes the "reviews" edge to the Review entity by IDs.
+func (m *PlanMutation) RemoveReviewIDs(ids ...int) {
+	if m.removedreviews == nil {
+		m.removedreviews = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reviews, ids[i])
+		m.removedreviews[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReviews returns the removed IDs of the "reviews" edge to the Review entity.
+func (m *PlanMutation) RemovedReviewsIDs() (ids []int) {
+	for id := range m.removedreviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReviewsIDs returns the "reviews" edge IDs in the mutation.
+func (m *PlanMutation) ReviewsIDs() (ids []int) {
+	for id := range m.reviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReviews resets all changes to the "reviews" edge.
+func (m *PlanMutation) ResetReviews() {
+	m.reviews = nil
+	m.clearedreviews = false
+	m.removedreviews = nil
+}
+
+// AddApplyRunIDs adds the "apply_runs" edge to the ApplyRun entity by ids.
+func (m *PlanMutation) AddApplyRunIDs(ids ...int) {
+	if m.apply_runs == nil {
+		m.apply_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.apply_runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApplyRuns clears the "apply_runs" edge to the ApplyRun entity.
+func (m *PlanMutation) ClearApplyRuns() {
+	m.clearedapply_runs = true
+}
+
+// ApplyRunsCleared reports if the "apply_runs" edge to the ApplyRun entity was cleared.
+func (m *PlanMutation) ApplyRunsCleared() bool {
+	return m.clearedapply_runs
+}
+
+// RemoveApplyRunIDs removes the "apply_runs" edge to the ApplyRun entity by IDs.
+func (m *PlanMutation) RemoveApplyRunIDs(ids ...int) {
+	if m.removedapply_runs == nil {
+		m.removedapply_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.apply_runs, ids[i])
+		m.removedapply_runs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApplyRuns returns the removed IDs of the "apply_runs" edge to the ApplyRun entity.
+func (m *PlanMutation) RemovedApplyRunsIDs() (ids []int) {
+	for id := range m.removedapply_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApplyRunsIDs returns the "apply_runs" edge IDs in the mutation.
+func (m *PlanMutation) ApplyRunsIDs() (ids []int) {
+	for id := range m.apply_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApplyRuns resets all changes to the "apply_runs" edge.
+func (m *PlanMutation) ResetApplyRuns() {
+	m.apply_runs = nil
+	m.clearedapply_runs = false
+	m.removedapply_runs = nil
+}
+
+// Where appends a list predicates to the PlanMutation builder.
+func (m *PlanMutation) Where(ps ...predicate.Plan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Plan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Plan).
+func (m *PlanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlanMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.repository != nil {
+		fields = append(fields, plan.FieldRepository)
+	}
+	if m.query != nil {
+		fields = append(fields, plan.FieldQuery)
+	}
+	if m.created_at != nil {
+		fields = append(fields, plan.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, plan.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldRepository:
+		return m.Repository()
+	case plan.FieldQuery:
+		return m.Query()
+	case plan.FieldCreatedAt:
+		return m.CreatedAt()
+	case plan.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case plan.FieldRepository:
+		return m.OldRepository(ctx)
+	case plan.FieldQuery:
+		return m.OldQuery(ctx)
+	case plan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case plan.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Plan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldRepository:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepository(v)
+		return nil
+	case plan.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case plan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case plan.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlanMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlanMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Plan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlanMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlanMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Plan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlanMutation) ResetField(name string) error {
+	switch name {
+	case plan.FieldRepository:
+		m.ResetRepository()
+		return nil
+	case plan.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case plan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case plan.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.block_changes != nil {
+		edges = append(edges, plan.EdgeBlockChanges)
+	}
+	if m.reviews != nil {
+		edges = append(edges, plan.EdgeReviews)
+	}
+	if m.apply_runs != nil {
+		edges = append(edges, plan.EdgeApplyRuns)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeBlockChanges:
+		ids := make([]ent.Value, 0, len(m.block_changes))
+		for id := range m.block_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case plan.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.reviews))
+		for id := range m.reviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case plan.EdgeApplyRuns:
+		ids := make([]ent.Value, 0, len(m.apply_runs))
+		for id := range m.apply_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedblock_changes != nil {
+		edges = append(edges, plan.EdgeBlockChanges)
+	}
+	if m.removedreviews != nil {
+		edges = append(edges, plan.EdgeReviews)
+	}
+	if m.removedapply_runs != nil {
+		edges = append(edges, plan.EdgeApplyRuns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeBlockChanges:
+		ids := make([]ent.Value, 0, len(m.removedblock_changes))
+		for id := range m.removedblock_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case plan.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.removedreviews))
+		for id := range m.removedreviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case plan.EdgeApplyRuns:
+		ids := make([]ent.Value, 0, len(m.removedapply_runs))
+		for id := range m.removedapply_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedblock_changes {
+		edges = append(edges, plan.EdgeBlockChanges)
+	}
+	if m.clearedreviews {
+		edges = append(edges, plan.EdgeReviews)
+	}
+	if m.clearedapply_runs {
+		edges = append(edges, plan.EdgeApplyRuns)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case plan.EdgeBlockChanges:
+		return m.clearedblock_changes
+	case plan.EdgeReviews:
+		return m.clearedreviews
+	case plan.EdgeApplyRuns:
+		return m.clearedapply_runs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlanMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Plan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlanMutation) ResetEdge(name string) error {
+	switch name {
+	case plan.EdgeBlockChanges:
+		m.ResetBlockChanges()
+		return nil
+	case plan.EdgeReviews:
+		m.ResetReviews()
+		return nil
+	case plan.EdgeApplyRuns:
+		m.ResetApplyRuns()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan edge %s", name)
+}
+
+// ReviewMutation represents an operation that mutates the Review nodes in the graph.
+type ReviewMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	approved      *bool
+	comment       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	plan          *string
+	clearedplan   bool
+	done          bool
+	oldValue      func(context.Context) (*Review, error)
+	predicates    []predicate.Review
+}
+
+var _ ent.Mutation = (*ReviewMutation)(nil)
+
+// reviewOption allows management of the mutation configuration using functional options.
+type reviewOption func(*ReviewMutation)
+
+// newReviewMutation creates new mutation for the Review entity.
+func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
+	m := &ReviewMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReview,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewID sets the ID field of the mutation.
+func withReviewID(id int) reviewOption {
+	return func(m *ReviewMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Review
+		)
+		m.oldValue = func(ctx context.Context) (*Review, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Review.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReview sets the old Review of the mutation.
+func withReview(node *Review) reviewOption {
+	return func(m *ReviewMutation) {
+		m.oldValue = func(context.Context) (*Review, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Review.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPlanID sets the "plan_id" field.
+func (m *ReviewMutation) SetPlanID(s string) {
+	m.plan = &s
+}
+
+// PlanID returns the value of the "plan_id" field in the mutation.
+func (m *ReviewMutation) PlanID() (r string, exists bool) {
+	v := m.plan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlanID returns the old "plan_id" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldPlanID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
+	}
+	return oldValue.PlanID, nil
+}
+
+// ResetPlanID resets all changes to the "plan_id" field.
+func (m *ReviewMutation) ResetPlanID() {
+	m.plan = nil
+}
+
+// SetApproved sets the "approved" field.
+func (m *ReviewMutation) SetApproved(b bool) {
+	m.approved = &b
+}
+
+// Approved returns the value of the "approved" field in the mutation.
+func (m *ReviewMutation) Approved() (r bool, exists bool) {
+	v := m.approved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApproved returns the old "approved" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldApproved(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApproved is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApproved requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApproved: %w", err)
+	}
+	return oldValue.Approved, nil
+}
+
+// ResetApproved resets all changes to the "approved" field.
+func (m *ReviewMutation) ResetApproved() {
+	m.approved = nil
+}
+
+// SetComment sets the "comment" field.
+func (m *ReviewMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *ReviewMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *ReviewMutation) ResetComment() {
+	m.comment = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (m *ReviewMutation) ClearPlan() {
+	m.clearedplan = true
+	m.clearedFields[review.FieldPlanID] = struct{}{}
+}
+
+// PlanCleared reports if the "plan" edge to the Plan entity was cleared.
+func (m *ReviewMutation) PlanCleared() bool {
+	return m.clearedplan
+}
+
+// PlanIDs returns the "plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlanID instead. It exists only for internal usage by the builders.
+func (m *ReviewMutation) PlanIDs() (ids []string) {
+	if id := m.plan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlan resets all changes to the "plan" edge.
+func (m *ReviewMutation) ResetPlan() {
+	m.plan = nil
+	m.clearedplan = false
+}
+
+// Where appends a list predicates to the ReviewMutation builder.
+func (m *ReviewMutation) Where(ps ...predicate.Review) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReviewMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReviewMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Review, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReviewMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReviewMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Review).
+func (m *ReviewMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReviewMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.plan != nil {
+		fields = append(fields, review.FieldPlanID)
+	}
+	if m.approved != nil {
+		fields = append(fields, review.FieldApproved)
+	}
+	if m.comment != nil {
+		fields = append(fields, review.FieldComment)
+	}
+	if m.created_at != nil {
+		fields = append(fields, review.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case review.FieldPlanID:
+		return m.PlanID()
+	case review.FieldApproved:
+		return m.Approved()
+	case review.FieldComment:
+		return m.Comment()
+	case review.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case review.FieldPlanID:
+		return m.OldPlanID(ctx)
+	case review.FieldApproved:
+		return m.OldApproved(ctx)
+	case review.FieldComment:
+		return m.OldComment(ctx)
+	case review.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Review field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case review.FieldPlanID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlanID(v)
+		return nil
+	case review.FieldApproved:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApproved(v)
+		return nil
+	case review.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case review.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Review field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReviewMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReviewMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Review numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReviewMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReviewMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReviewMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Review nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReviewMutation) ResetField(name string) error {
+	switch name {
+	case review.FieldPlanID:
+		m.ResetPlanID()
+		return nil
+	case review.FieldApproved:
+		m.ResetApproved()
+		return nil
+	case review.FieldComment:
+		m.ResetComment()
+		return nil
+	case review.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Review field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReviewMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.plan != nil {
+		edges = append(edges, review.EdgePlan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReviewMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case review.EdgePlan:
+		if id := m.plan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReviewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReviewMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReviewMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedplan {
+		edges = append(edges, review.EdgePlan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReviewMutation) EdgeCleared(name string) bool {
+	switch name {
+	case review.EdgePlan:
+		return m.clearedplan
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DocumentMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Document unique edge %s", name)
+func (m *ReviewMutation) ClearEdge(name string) error {
+	switch name {
+	case review.EdgePlan:
+		m.ClearPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown Review unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DocumentMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Document edge %s", name)
+func (m *ReviewMutation) ResetEdge(name string) error {
+	switch name {
+	case review.EdgePlan:
+		m.ResetPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown Review edge %s", name)
 }
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nakamasato/aicoder/ent/plan"
+)
+
+// Plan is the model entity for the Plan schema.
+type Plan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Repository holds the value of the "repository" field.
+	Repository string `json:"repository,omitempty"`
+	// Query holds the value of the "query" field.
+	Query string `json:"query,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlanQuery when eager-loading is set.
+	Edges        PlanEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlanEdges holds the relations/edges for other nodes in the graph.
+type PlanEdges struct {
+	// BlockChanges holds the value of the block_changes edge.
+	BlockChanges []*BlockChange `json:"block_changes,omitempty"`
+	// Reviews holds the value of the reviews edge.
+	Reviews []*Review `json:"reviews,omitempty"`
+	// ApplyRuns holds the value of the apply_runs edge.
+	ApplyRuns []*ApplyRun `json:"apply_runs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// BlockChangesOrErr returns the BlockChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlanEdges) BlockChangesOrErr() ([]*BlockChange, error) {
+	if e.loadedTypes[0] {
+		return e.BlockChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "block_changes"}
+}
+
+// ReviewsOrErr returns the Reviews value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlanEdges) ReviewsOrErr() ([]*Review, error) {
+	if e.loadedTypes[1] {
+		return e.Reviews, nil
+	}
+	return nil, &NotLoadedError{edge: "reviews"}
+}
+
+// ApplyRunsOrErr returns the ApplyRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlanEdges) ApplyRunsOrErr() ([]*ApplyRun, error) {
+	if e.loadedTypes[2] {
+		return e.ApplyRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "apply_runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Plan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case plan.FieldID, plan.FieldRepository, plan.FieldQuery:
+			values[i] = new(sql.NullString)
+		case plan.FieldCreatedAt, plan.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Plan fields.
+func (pl *Plan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case plan.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pl.ID = value.String
+			}
+		case plan.FieldRepository:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repository", values[i])
+			} else if value.Valid {
+				pl.Repository = value.String
+			}
+		case plan.FieldQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value.Valid {
+				pl.Query = value.String
+			}
+		case plan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pl.CreatedAt = value.Time
+			}
+		case plan.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pl.UpdatedAt = value.Time
+			}
+		default:
+			pl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Plan.
+// This includes values selected through modifiers, order, etc.
+func (pl *Plan) Value(name string) (ent.Value, error) {
+	return pl.selectValues.Get(name)
+}
+
+// QueryBlockChanges queries the "block_changes" edge of the Plan entity.
+func (pl *Plan) QueryBlockChanges() *BlockChangeQuery {
+	return NewPlanClient(pl.config).QueryBlockChanges(pl)
+}
+
+// QueryReviews queries the "reviews" edge of the Plan entity.
+func (pl *Plan) QueryReviews() *ReviewQuery {
+	return NewPlanClient(pl.config).QueryReviews(pl)
+}
+
+// QueryApplyRuns queries the "apply_runs" edge of the Plan entity.
+func (pl *Plan) QueryApplyRuns() *ApplyRunQuery {
+	return NewPlanClient(pl.config).QueryApplyRuns(pl)
+}
+
+// Update returns a builder for updating this Plan.
+// Note that you need to call Plan.Unwrap() before calling this method if this Plan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pl *Plan) Update() *PlanUpdateOne {
+	return NewPlanClient(pl.config).UpdateOne(pl)
+}
+
+// Unwrap unwraps the Plan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pl *Plan) Unwrap() *Plan {
+	_tx, ok := pl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Plan is not a transactional entity")
+	}
+	pl.config.driver = _tx.drv
+	return pl
+}
+
+// String implements the fmt.Stringer.
+func (pl *Plan) String() string {
+	var builder strings.Builder
+	builder.WriteString("Plan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pl.ID))
+	builder.WriteString("repository=")
+	builder.WriteString(pl.Repository)
+	builder.WriteString(", ")
+	builder.WriteString("query=")
+	builder.WriteString(pl.Query)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Plans is a parsable slice of Plan.
+type Plans []*Plan
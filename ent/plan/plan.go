@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the plan type in the database.
+	Label = "plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRepository holds the string denoting the repository field in the database.
+	FieldRepository = "repository"
+	// FieldQuery holds the string denoting the query field in the database.
+	FieldQuery = "query"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeBlockChanges holds the string denoting the block_changes edge name in mutations.
+	EdgeBlockChanges = "block_changes"
+	// EdgeReviews holds the string denoting the reviews edge name in mutations.
+	EdgeReviews = "reviews"
+	// EdgeApplyRuns holds the string denoting the apply_runs edge name in mutations.
+	EdgeApplyRuns = "apply_runs"
+	// Table holds the table name of the plan in the database.
+	Table = "plans"
+	// BlockChangesTable is the table that holds the block_changes relation/edge.
+	BlockChangesTable = "block_changes"
+	// BlockChangesInverseTable is the table name for the BlockChange entity.
+	// It exists in this package in order to avoid circular dependency with the "blockchange" package.
+	BlockChangesInverseTable = "block_changes"
+	// BlockChangesColumn is the table column denoting the block_changes relation/edge.
+	BlockChangesColumn = "plan_id"
+	// ReviewsTable is the table that holds the reviews relation/edge.
+	ReviewsTable = "reviews"
+	// ReviewsInverseTable is the table name for the Review entity.
+	// It exists in this package in order to avoid circular dependency with the "review" package.
+	ReviewsInverseTable = "reviews"
+	// ReviewsColumn is the table column denoting the reviews relation/edge.
+	ReviewsColumn = "plan_id"
+	// ApplyRunsTable is the table that holds the apply_runs relation/edge.
+	ApplyRunsTable = "apply_runs"
+	// ApplyRunsInverseTable is the table name for the ApplyRun entity.
+	// It exists in this package in order to avoid circular dependency with the "applyrun" package.
+	ApplyRunsInverseTable = "apply_runs"
+	// ApplyRunsColumn is the table column denoting the apply_runs relation/edge.
+	ApplyRunsColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for plan fields.
+var Columns = []string{
+	FieldID,
+	FieldRepository,
+	FieldQuery,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRepository holds the default value on creation for the "repository" field.
+	DefaultRepository string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Plan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRepository orders the results by the repository field.
+func ByRepository(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepository, opts...).ToFunc()
+}
+
+// ByQuery orders the results by the query field.
+func ByQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuery, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByBlockChangesCount orders the results by block_changes count.
+func ByBlockChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockChangesStep(), opts...)
+	}
+}
+
+// ByBlockChanges orders the results by block_changes terms.
+func ByBlockChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewsCount orders the results by reviews count.
+func ByReviewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewsStep(), opts...)
+	}
+}
+
+// ByReviews orders the results by reviews terms.
+func ByReviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByApplyRunsCount orders the results by apply_runs count.
+func ByApplyRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newApplyRunsStep(), opts...)
+	}
+}
+
+// ByApplyRuns orders the results by apply_runs terms.
+func ByApplyRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newApplyRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBlockChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlockChangesTable, BlockChangesColumn),
+	)
+}
+func newReviewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+	)
+}
+func newApplyRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ApplyRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ApplyRunsTable, ApplyRunsColumn),
+	)
+}
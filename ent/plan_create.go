@@ -0,0 +1,753 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/review"
+)
+
+// PlanCreate is the builder for creating a Plan entity.
+type PlanCreate struct {
+	config
+	mutation *PlanMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRepository sets the "repository" field.
+func (pc *PlanCreate) SetRepository(s string) *PlanCreate {
+	pc.mutation.SetRepository(s)
+	return pc
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableRepository(s *string) *PlanCreate {
+	if s != nil {
+		pc.SetRepository(*s)
+	}
+	return pc
+}
+
+// SetQuery sets the "query" field.
+func (pc *PlanCreate) SetQuery(s string) *PlanCreate {
+	pc.mutation.SetQuery(s)
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PlanCreate) SetCreatedAt(t time.Time) *PlanCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableCreatedAt(t *time.Time) *PlanCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PlanCreate) SetUpdatedAt(t time.Time) *PlanCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableUpdatedAt(t *time.Time) *PlanCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PlanCreate) SetID(s string) *PlanCreate {
+	pc.mutation.SetID(s)
+	return pc
+}
+
+// AddBlockChangeIDs adds the "block_changes" edge to the BlockChange entity by IDs.
+func (pc *PlanCreate) AddBlockChangeIDs(ids ...int) *PlanCreate {
+	pc.mutation.AddBlockChangeIDs(ids...)
+	return pc
+}
+
+// AddBlockChanges adds the "block_changes" edges to the BlockChange entity.
+func (pc *PlanCreate) AddBlockChanges(b ...*BlockChange) *PlanCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pc.AddBlockChangeIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (pc *PlanCreate) AddReviewIDs(ids ...int) *PlanCreate {
+	pc.mutation.AddReviewIDs(ids...)
+	return pc
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (pc *PlanCreate) AddReviews(r ...*Review) *PlanCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddReviewIDs(ids...)
+}
+
+// AddApplyRunIDs adds the "apply_runs" edge to the ApplyRun entity by IDs.
+func (pc *PlanCreate) AddApplyRunIDs(ids ...int) *PlanCreate {
+	pc.mutation.AddApplyRunIDs(ids...)
+	return pc
+}
+
+// AddApplyRuns adds the "apply_runs" edges to the ApplyRun entity.
+func (pc *PlanCreate) AddApplyRuns(a ...*ApplyRun) *PlanCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pc.AddApplyRunIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (pc *PlanCreate) Mutation() *PlanMutation {
+	return pc.mutation
+}
+
+// Save creates the Plan in the database.
+func (pc *PlanCreate) Save(ctx context.Context) (*Plan, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PlanCreate) SaveX(ctx context.Context) *Plan {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PlanCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PlanCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PlanCreate) defaults() {
+	if _, ok := pc.mutation.Repository(); !ok {
+		v := plan.DefaultRepository
+		pc.mutation.SetRepository(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := plan.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := plan.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PlanCreate) check() error {
+	if _, ok := pc.mutation.Repository(); !ok {
+		return &ValidationError{Name: "repository", err: errors.New(`ent: missing required field "Plan.repository"`)}
+	}
+	if _, ok := pc.mutation.Query(); !ok {
+		return &ValidationError{Name: "query", err: errors.New(`ent: missing required field "Plan.query"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Plan.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Plan.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *PlanCreate) sqlSave(ctx context.Context) (*Plan, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Plan.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PlanCreate) createSpec() (*Plan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Plan{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(plan.Table, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = pc.conflict
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.Repository(); ok {
+		_spec.SetField(plan.FieldRepository, field.TypeString, value)
+		_node.Repository = value
+	}
+	if value, ok := pc.mutation.Query(); ok {
+		_spec.SetField(plan.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.BlockChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.BlockChangesTable,
+			Columns: []string{plan.BlockChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ReviewsTable,
+			Columns: []string{plan.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ApplyRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ApplyRunsTable,
+			Columns: []string{plan.ApplyRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Plan.Create().
+//		SetRepository(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlanUpsert) {
+//			SetRepository(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PlanCreate) OnConflict(opts ...sql.ConflictOption) *PlanUpsertOne {
+	pc.conflict = opts
+	return &PlanUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PlanCreate) OnConflictColumns(columns ...string) *PlanUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PlanUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PlanUpsertOne is the builder for "upsert"-ing
+	//  one Plan node.
+	PlanUpsertOne struct {
+		create *PlanCreate
+	}
+
+	// PlanUpsert is the "OnConflict" setter.
+	PlanUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRepository sets the "repository" field.
+func (u *PlanUpsert) SetRepository(v string) *PlanUpsert {
+	u.Set(plan.FieldRepository, v)
+	return u
+}
+
+// UpdateRepository sets the "repository" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateRepository() *PlanUpsert {
+	u.SetExcluded(plan.FieldRepository)
+	return u
+}
+
+// SetQuery sets the "query" field.
+func (u *PlanUpsert) SetQuery(v string) *PlanUpsert {
+	u.Set(plan.FieldQuery, v)
+	return u
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateQuery() *PlanUpsert {
+	u.SetExcluded(plan.FieldQuery)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlanUpsert) SetUpdatedAt(v time.Time) *PlanUpsert {
+	u.Set(plan.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlanUpsert) UpdateUpdatedAt() *PlanUpsert {
+	u.SetExcluded(plan.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(plan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlanUpsertOne) UpdateNewValues() *PlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(plan.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(plan.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlanUpsertOne) Ignore() *PlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlanUpsertOne) DoNothing() *PlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlanCreate.OnConflict
+// documentation for more info.
+func (u *PlanUpsertOne) Update(set func(*PlanUpsert)) *PlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRepository sets the "repository" field.
+func (u *PlanUpsertOne) SetRepository(v string) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetRepository(v)
+	})
+}
+
+// UpdateRepository sets the "repository" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateRepository() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateRepository()
+	})
+}
+
+// SetQuery sets the "query" field.
+func (u *PlanUpsertOne) SetQuery(v string) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetQuery(v)
+	})
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateQuery() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateQuery()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlanUpsertOne) SetUpdatedAt(v time.Time) *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlanUpsertOne) UpdateUpdatedAt() *PlanUpsertOne {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PlanUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlanCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlanUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlanUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PlanUpsertOne.ID is not supported by MySQL driver. Use PlanUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlanUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PlanCreateBulk is the builder for creating many Plan entities in bulk.
+type PlanCreateBulk struct {
+	config
+	err      error
+	builders []*PlanCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Plan entities in the database.
+func (pcb *PlanCreateBulk) Save(ctx context.Context) ([]*Plan, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Plan, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PlanCreateBulk) SaveX(ctx context.Context) []*Plan {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PlanCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Plan.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlanUpsert) {
+//			SetRepository(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PlanCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlanUpsertBulk {
+	pcb.conflict = opts
+	return &PlanUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PlanCreateBulk) OnConflictColumns(columns ...string) *PlanUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PlanUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PlanUpsertBulk is the builder for "upsert"-ing
+// a bulk of Plan nodes.
+type PlanUpsertBulk struct {
+	create *PlanCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(plan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlanUpsertBulk) UpdateNewValues() *PlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(plan.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(plan.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Plan.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlanUpsertBulk) Ignore() *PlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlanUpsertBulk) DoNothing() *PlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlanCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlanUpsertBulk) Update(set func(*PlanUpsert)) *PlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRepository sets the "repository" field.
+func (u *PlanUpsertBulk) SetRepository(v string) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetRepository(v)
+	})
+}
+
+// UpdateRepository sets the "repository" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateRepository() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateRepository()
+	})
+}
+
+// SetQuery sets the "query" field.
+func (u *PlanUpsertBulk) SetQuery(v string) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetQuery(v)
+	})
+}
+
+// UpdateQuery sets the "query" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateQuery() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateQuery()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlanUpsertBulk) SetUpdatedAt(v time.Time) *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlanUpsertBulk) UpdateUpdatedAt() *PlanUpsertBulk {
+	return u.Update(func(s *PlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PlanUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PlanCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PlanCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlanUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,769 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/predicate"
+	"github.com/nakamasato/aicoder/ent/review"
+)
+
+// PlanUpdate is the builder for updating Plan entities.
+type PlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (pu *PlanUpdate) Where(ps ...predicate.Plan) *PlanUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetRepository sets the "repository" field.
+func (pu *PlanUpdate) SetRepository(s string) *PlanUpdate {
+	pu.mutation.SetRepository(s)
+	return pu
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableRepository(s *string) *PlanUpdate {
+	if s != nil {
+		pu.SetRepository(*s)
+	}
+	return pu
+}
+
+// SetQuery sets the "query" field.
+func (pu *PlanUpdate) SetQuery(s string) *PlanUpdate {
+	pu.mutation.SetQuery(s)
+	return pu
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableQuery(s *string) *PlanUpdate {
+	if s != nil {
+		pu.SetQuery(*s)
+	}
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PlanUpdate) SetUpdatedAt(t time.Time) *PlanUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// AddBlockChangeIDs adds the "block_changes" edge to the BlockChange entity by IDs.
+func (pu *PlanUpdate) AddBlockChangeIDs(ids ...int) *PlanUpdate {
+	pu.mutation.AddBlockChangeIDs(ids...)
+	return pu
+}
+
+// AddBlockChanges adds the "block_changes" edges to the BlockChange entity.
+func (pu *PlanUpdate) AddBlockChanges(b ...*BlockChange) *PlanUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pu.AddBlockChangeIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (pu *PlanUpdate) AddReviewIDs(ids ...int) *PlanUpdate {
+	pu.mutation.AddReviewIDs(ids...)
+	return pu
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (pu *PlanUpdate) AddReviews(r ...*Review) *PlanUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddReviewIDs(ids...)
+}
+
+// AddApplyRunIDs adds the "apply_runs" edge to the ApplyRun entity by IDs.
+func (pu *PlanUpdate) AddApplyRunIDs(ids ...int) *PlanUpdate {
+	pu.mutation.AddApplyRunIDs(ids...)
+	return pu
+}
+
+// AddApplyRuns adds the "apply_runs" edges to the ApplyRun entity.
+func (pu *PlanUpdate) AddApplyRuns(a ...*ApplyRun) *PlanUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pu.AddApplyRunIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (pu *PlanUpdate) Mutation() *PlanMutation {
+	return pu.mutation
+}
+
+// ClearBlockChanges clears all "block_changes" edges to the BlockChange entity.
+func (pu *PlanUpdate) ClearBlockChanges() *PlanUpdate {
+	pu.mutation.ClearBlockChanges()
+	return pu
+}
+
+// RemoveBlockChangeIDs removes the "block_changes" edge to BlockChange entities by IDs.
+func (pu *PlanUpdate) RemoveBlockChangeIDs(ids ...int) *PlanUpdate {
+	pu.mutation.RemoveBlockChangeIDs(ids...)
+	return pu
+}
+
+// RemoveBlockChanges removes "block_changes" edges to BlockChange entities.
+func (pu *PlanUpdate) RemoveBlockChanges(b ...*BlockChange) *PlanUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pu.RemoveBlockChangeIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (pu *PlanUpdate) ClearReviews() *PlanUpdate {
+	pu.mutation.ClearReviews()
+	return pu
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (pu *PlanUpdate) RemoveReviewIDs(ids ...int) *PlanUpdate {
+	pu.mutation.RemoveReviewIDs(ids...)
+	return pu
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (pu *PlanUpdate) RemoveReviews(r ...*Review) *PlanUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveReviewIDs(ids...)
+}
+
+// ClearApplyRuns clears all "apply_runs" edges to the ApplyRun entity.
+func (pu *PlanUpdate) ClearApplyRuns() *PlanUpdate {
+	pu.mutation.ClearApplyRuns()
+	return pu
+}
+
+// RemoveApplyRunIDs removes the "apply_runs" edge to ApplyRun entities by IDs.
+func (pu *PlanUpdate) RemoveApplyRunIDs(ids ...int) *PlanUpdate {
+	pu.mutation.RemoveApplyRunIDs(ids...)
+	return pu
+}
+
+// RemoveApplyRuns removes "apply_runs" edges to ApplyRun entities.
+func (pu *PlanUpdate) RemoveApplyRuns(a ...*ApplyRun) *PlanUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return pu.RemoveApplyRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PlanUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PlanUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PlanUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PlanUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := plan.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (pu *PlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Repository(); ok {
+		_spec.SetField(plan.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Query(); ok {
+		_spec.SetField(plan.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.BlockChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.BlockChangesTable,
+			Columns: []string{plan.BlockChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedBlockChangesIDs(); len(nodes) > 0 && !pu.mutation.BlockChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.BlockChangesTable,
+			Columns: []string{plan.BlockChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.BlockChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.BlockChangesTable,
+			Columns: []string{plan.BlockChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ReviewsTable,
+			Columns: []string{plan.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !pu.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ReviewsTable,
+			Columns: []string{plan.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ReviewsTable,
+			Columns: []string{plan.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ApplyRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ApplyRunsTable,
+			Columns: []string{plan.ApplyRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedApplyRunsIDs(); len(nodes) > 0 && !pu.mutation.ApplyRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ApplyRunsTable,
+			Columns: []string{plan.ApplyRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ApplyRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ApplyRunsTable,
+			Columns: []string{plan.ApplyRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PlanUpdateOne is the builder for updating a single Plan entity.
+type PlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// SetRepository sets the "repository" field.
+func (puo *PlanUpdateOne) SetRepository(s string) *PlanUpdateOne {
+	puo.mutation.SetRepository(s)
+	return puo
+}
+
+// SetNillableRepository sets the "repository" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableRepository(s *string) *PlanUpdateOne {
+	if s != nil {
+		puo.SetRepository(*s)
+	}
+	return puo
+}
+
+// SetQuery sets the "query" field.
+func (puo *PlanUpdateOne) SetQuery(s string) *PlanUpdateOne {
+	puo.mutation.SetQuery(s)
+	return puo
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableQuery(s *string) *PlanUpdateOne {
+	if s != nil {
+		puo.SetQuery(*s)
+	}
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PlanUpdateOne) SetUpdatedAt(t time.Time) *PlanUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// AddBlockChangeIDs adds the "block_changes" edge to the BlockChange entity by IDs.
+func (puo *PlanUpdateOne) AddBlockChangeIDs(ids ...int) *PlanUpdateOne {
+	puo.mutation.AddBlockChangeIDs(ids...)
+	return puo
+}
+
+// AddBlockChanges adds the "block_changes" edges to the BlockChange entity.
+func (puo *PlanUpdateOne) AddBlockChanges(b ...*BlockChange) *PlanUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return puo.AddBlockChangeIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (puo *PlanUpdateOne) AddReviewIDs(ids ...int) *PlanUpdateOne {
+	puo.mutation.AddReviewIDs(ids...)
+	return puo
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (puo *PlanUpdateOne) AddReviews(r ...*Review) *PlanUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddReviewIDs(ids...)
+}
+
+// AddApplyRunIDs adds the "apply_runs" edge to the ApplyRun entity by IDs.
+func (puo *PlanUpdateOne) AddApplyRunIDs(ids ...int) *PlanUpdateOne {
+	puo.mutation.AddApplyRunIDs(ids...)
+	return puo
+}
+
+// AddApplyRuns adds the "apply_runs" edges to the ApplyRun entity.
+func (puo *PlanUpdateOne) AddApplyRuns(a ...*ApplyRun) *PlanUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return puo.AddApplyRunIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (puo *PlanUpdateOne) Mutation() *PlanMutation {
+	return puo.mutation
+}
+
+// ClearBlockChanges clears all "block_changes" edges to the BlockChange entity.
+func (puo *PlanUpdateOne) ClearBlockChanges() *PlanUpdateOne {
+	puo.mutation.ClearBlockChanges()
+	return puo
+}
+
+// RemoveBlockChangeIDs removes the "block_changes" edge to BlockChange entities by IDs.
+func (puo *PlanUpdateOne) RemoveBlockChangeIDs(ids ...int) *PlanUpdateOne {
+	puo.mutation.RemoveBlockChangeIDs(ids...)
+	return puo
+}
+
+// RemoveBlockChanges removes "block_changes" edges to BlockChange entities.
+func (puo *PlanUpdateOne) RemoveBlockChanges(b ...*BlockChange) *PlanUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return puo.RemoveBlockChangeIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (puo *PlanUpdateOne) ClearReviews() *PlanUpdateOne {
+	puo.mutation.ClearReviews()
+	return puo
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (puo *PlanUpdateOne) RemoveReviewIDs(ids ...int) *PlanUpdateOne {
+	puo.mutation.RemoveReviewIDs(ids...)
+	return puo
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (puo *PlanUpdateOne) RemoveReviews(r ...*Review) *PlanUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveReviewIDs(ids...)
+}
+
+// ClearApplyRuns clears all "apply_runs" edges to the ApplyRun entity.
+func (puo *PlanUpdateOne) ClearApplyRuns() *PlanUpdateOne {
+	puo.mutation.ClearApplyRuns()
+	return puo
+}
+
+// RemoveApplyRunIDs removes the "apply_runs" edge to ApplyRun entities by IDs.
+func (puo *PlanUpdateOne) RemoveApplyRunIDs(ids ...int) *PlanUpdateOne {
+	puo.mutation.RemoveApplyRunIDs(ids...)
+	return puo
+}
+
+// RemoveApplyRuns removes "apply_runs" edges to ApplyRun entities.
+func (puo *PlanUpdateOne) RemoveApplyRuns(a ...*ApplyRun) *PlanUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return puo.RemoveApplyRunIDs(ids...)
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (puo *PlanUpdateOne) Where(ps ...predicate.Plan) *PlanUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PlanUpdateOne) Select(field string, fields ...string) *PlanUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Plan entity.
+func (puo *PlanUpdateOne) Save(ctx context.Context) (*Plan, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PlanUpdateOne) SaveX(ctx context.Context) *Plan {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PlanUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PlanUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := plan.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (puo *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error) {
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Plan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, plan.FieldID)
+		for _, f := range fields {
+			if !plan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != plan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Repository(); ok {
+		_spec.SetField(plan.FieldRepository, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Query(); ok {
+		_spec.SetField(plan.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.BlockChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.BlockChangesTable,
+			Columns: []string{plan.BlockChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedBlockChangesIDs(); len(nodes) > 0 && !puo.mutation.BlockChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.BlockChangesTable,
+			Columns: []string{plan.BlockChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.BlockChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.BlockChangesTable,
+			Columns: []string{plan.BlockChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ReviewsTable,
+			Columns: []string{plan.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !puo.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ReviewsTable,
+			Columns: []string{plan.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ReviewsTable,
+			Columns: []string{plan.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ApplyRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ApplyRunsTable,
+			Columns: []string{plan.ApplyRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedApplyRunsIDs(); len(nodes) > 0 && !puo.mutation.ApplyRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ApplyRunsTable,
+			Columns: []string{plan.ApplyRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ApplyRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.ApplyRunsTable,
+			Columns: []string{plan.ApplyRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(applyrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Plan{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
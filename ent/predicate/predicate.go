@@ -6,5 +6,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApplyRun is the predicate function for applyrun builders.
+type ApplyRun func(*sql.Selector)
+
+// BlockChange is the predicate function for blockchange builders.
+type BlockChange func(*sql.Selector)
+
 // Document is the predicate function for document builders.
 type Document func(*sql.Selector)
+
+// Plan is the predicate function for plan builders.
+type Plan func(*sql.Selector)
+
+// Review is the predicate function for review builders.
+type Review func(*sql.Selector)
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/review"
+)
+
+// Review is the model entity for the Review schema.
+type Review struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID string `json:"plan_id,omitempty"`
+	// Approved holds the value of the "approved" field.
+	Approved bool `json:"approved,omitempty"`
+	// Comment holds the value of the "comment" field.
+	Comment string `json:"comment,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReviewQuery when eager-loading is set.
+	Edges        ReviewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReviewEdges holds the relations/edges for other nodes in the graph.
+type ReviewEdges struct {
+	// Plan holds the value of the plan edge.
+	Plan *Plan `json:"plan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReviewEdges) PlanOrErr() (*Plan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: plan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Review) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case review.FieldApproved:
+			values[i] = new(sql.NullBool)
+		case review.FieldID:
+			values[i] = new(sql.NullInt64)
+		case review.FieldPlanID, review.FieldComment:
+			values[i] = new(sql.NullString)
+		case review.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Review fields.
+func (r *Review) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case review.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case review.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				r.PlanID = value.String
+			}
+		case review.FieldApproved:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field approved", values[i])
+			} else if value.Valid {
+				r.Approved = value.Bool
+			}
+		case review.FieldComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment", values[i])
+			} else if value.Valid {
+				r.Comment = value.String
+			}
+		case review.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Review.
+// This includes values selected through modifiers, order, etc.
+func (r *Review) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryPlan queries the "plan" edge of the Review entity.
+func (r *Review) QueryPlan() *PlanQuery {
+	return NewReviewClient(r.config).QueryPlan(r)
+}
+
+// Update returns a builder for updating this Review.
+// Note that you need to call Review.Unwrap() before calling this method if this Review
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Review) Update() *ReviewUpdateOne {
+	return NewReviewClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Review entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Review) Unwrap() *Review {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Review is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Review) String() string {
+	var builder strings.Builder
+	builder.WriteString("Review(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("plan_id=")
+	builder.WriteString(r.PlanID)
+	builder.WriteString(", ")
+	builder.WriteString("approved=")
+	builder.WriteString(fmt.Sprintf("%v", r.Approved))
+	builder.WriteString(", ")
+	builder.WriteString("comment=")
+	builder.WriteString(r.Comment)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reviews is a parsable slice of Review.
+type Reviews []*Review
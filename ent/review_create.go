@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/review"
+)
+
+// ReviewCreate is the builder for creating a Review entity.
+type ReviewCreate struct {
+	config
+	mutation *ReviewMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlanID sets the "plan_id" field.
+func (rc *ReviewCreate) SetPlanID(s string) *ReviewCreate {
+	rc.mutation.SetPlanID(s)
+	return rc
+}
+
+// SetApproved sets the "approved" field.
+func (rc *ReviewCreate) SetApproved(b bool) *ReviewCreate {
+	rc.mutation.SetApproved(b)
+	return rc
+}
+
+// SetComment sets the "comment" field.
+func (rc *ReviewCreate) SetComment(s string) *ReviewCreate {
+	rc.mutation.SetComment(s)
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReviewCreate) SetCreatedAt(t time.Time) *ReviewCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableCreatedAt(t *time.Time) *ReviewCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (rc *ReviewCreate) SetPlan(p *Plan) *ReviewCreate {
+	return rc.SetPlanID(p.ID)
+}
+
+// Mutation returns the ReviewMutation object of the builder.
+func (rc *ReviewCreate) Mutation() *ReviewMutation {
+	return rc.mutation
+}
+
+// Save creates the Review in the database.
+func (rc *ReviewCreate) Save(ctx context.Context) (*Review, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReviewCreate) SaveX(ctx context.Context) *Review {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReviewCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReviewCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReviewCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := review.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReviewCreate) check() error {
+	if _, ok := rc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "Review.plan_id"`)}
+	}
+	if _, ok := rc.mutation.Approved(); !ok {
+		return &ValidationError{Name: "approved", err: errors.New(`ent: missing required field "Review.approved"`)}
+	}
+	if _, ok := rc.mutation.Comment(); !ok {
+		return &ValidationError{Name: "comment", err: errors.New(`ent: missing required field "Review.comment"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Review.created_at"`)}
+	}
+	if _, ok := rc.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan", err: errors.New(`ent: missing required edge "Review.plan"`)}
+	}
+	return nil
+}
+
+func (rc *ReviewCreate) sqlSave(ctx context.Context) (*Review, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Review{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(review.Table, sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rc.conflict
+	if value, ok := rc.mutation.Approved(); ok {
+		_spec.SetField(review.FieldApproved, field.TypeBool, value)
+		_node.Approved = value
+	}
+	if value, ok := rc.mutation.Comment(); ok {
+		_spec.SetField(review.FieldComment, field.TypeString, value)
+		_node.Comment = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(review.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.PlanTable,
+			Columns: []string{review.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Review.Create().
+//		SetPlanID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewUpsert) {
+//			SetPlanID(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ReviewCreate) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertOne {
+	rc.conflict = opts
+	return &ReviewUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ReviewCreate) OnConflictColumns(columns ...string) *ReviewUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ReviewUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ReviewUpsertOne is the builder for "upsert"-ing
+	//  one Review node.
+	ReviewUpsertOne struct {
+		create *ReviewCreate
+	}
+
+	// ReviewUpsert is the "OnConflict" setter.
+	ReviewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlanID sets the "plan_id" field.
+func (u *ReviewUpsert) SetPlanID(v string) *ReviewUpsert {
+	u.Set(review.FieldPlanID, v)
+	return u
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdatePlanID() *ReviewUpsert {
+	u.SetExcluded(review.FieldPlanID)
+	return u
+}
+
+// SetApproved sets the "approved" field.
+func (u *ReviewUpsert) SetApproved(v bool) *ReviewUpsert {
+	u.Set(review.FieldApproved, v)
+	return u
+}
+
+// UpdateApproved sets the "approved" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateApproved() *ReviewUpsert {
+	u.SetExcluded(review.FieldApproved)
+	return u
+}
+
+// SetComment sets the "comment" field.
+func (u *ReviewUpsert) SetComment(v string) *ReviewUpsert {
+	u.Set(review.FieldComment, v)
+	return u
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateComment() *ReviewUpsert {
+	u.SetExcluded(review.FieldComment)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReviewUpsertOne) UpdateNewValues() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(review.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReviewUpsertOne) Ignore() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewUpsertOne) DoNothing() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewCreate.OnConflict
+// documentation for more info.
+func (u *ReviewUpsertOne) Update(set func(*ReviewUpsert)) *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *ReviewUpsertOne) SetPlanID(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdatePlanID() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// SetApproved sets the "approved" field.
+func (u *ReviewUpsertOne) SetApproved(v bool) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetApproved(v)
+	})
+}
+
+// UpdateApproved sets the "approved" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateApproved() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateApproved()
+	})
+}
+
+// SetComment sets the "comment" field.
+func (u *ReviewUpsertOne) SetComment(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetComment(v)
+	})
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateComment() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateComment()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReviewUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReviewUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReviewCreateBulk is the builder for creating many Review entities in bulk.
+type ReviewCreateBulk struct {
+	config
+	err      error
+	builders []*ReviewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Review entities in the database.
+func (rcb *ReviewCreateBulk) Save(ctx context.Context) ([]*Review, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Review, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReviewCreateBulk) SaveX(ctx context.Context) []*Review {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReviewCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Review.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewUpsert) {
+//			SetPlanID(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ReviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertBulk {
+	rcb.conflict = opts
+	return &ReviewUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ReviewCreateBulk) OnConflictColumns(columns ...string) *ReviewUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ReviewUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ReviewUpsertBulk is the builder for "upsert"-ing
+// a bulk of Review nodes.
+type ReviewUpsertBulk struct {
+	create *ReviewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReviewUpsertBulk) UpdateNewValues() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(review.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReviewUpsertBulk) Ignore() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewUpsertBulk) DoNothing() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReviewUpsertBulk) Update(set func(*ReviewUpsert)) *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *ReviewUpsertBulk) SetPlanID(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdatePlanID() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// SetApproved sets the "approved" field.
+func (u *ReviewUpsertBulk) SetApproved(v bool) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetApproved(v)
+	})
+}
+
+// UpdateApproved sets the "approved" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateApproved() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateApproved()
+	})
+}
+
+// SetComment sets the "comment" field.
+func (u *ReviewUpsertBulk) SetComment(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetComment(v)
+	})
+}
+
+// UpdateComment sets the "comment" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateComment() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateComment()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReviewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
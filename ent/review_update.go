@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/predicate"
+	"github.com/nakamasato/aicoder/ent/review"
+)
+
+// ReviewUpdate is the builder for updating Review entities.
+type ReviewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReviewMutation
+}
+
+// Where appends a list predicates to the ReviewUpdate builder.
+func (ru *ReviewUpdate) Where(ps ...predicate.Review) *ReviewUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetPlanID sets the "plan_id" field.
+func (ru *ReviewUpdate) SetPlanID(s string) *ReviewUpdate {
+	ru.mutation.SetPlanID(s)
+	return ru
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillablePlanID(s *string) *ReviewUpdate {
+	if s != nil {
+		ru.SetPlanID(*s)
+	}
+	return ru
+}
+
+// SetApproved sets the "approved" field.
+func (ru *ReviewUpdate) SetApproved(b bool) *ReviewUpdate {
+	ru.mutation.SetApproved(b)
+	return ru
+}
+
+// SetNillableApproved sets the "approved" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableApproved(b *bool) *ReviewUpdate {
+	if b != nil {
+		ru.SetApproved(*b)
+	}
+	return ru
+}
+
+// SetComment sets the "comment" field.
+func (ru *ReviewUpdate) SetComment(s string) *ReviewUpdate {
+	ru.mutation.SetComment(s)
+	return ru
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableComment(s *string) *ReviewUpdate {
+	if s != nil {
+		ru.SetComment(*s)
+	}
+	return ru
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (ru *ReviewUpdate) SetPlan(p *Plan) *ReviewUpdate {
+	return ru.SetPlanID(p.ID)
+}
+
+// Mutation returns the ReviewMutation object of the builder.
+func (ru *ReviewUpdate) Mutation() *ReviewMutation {
+	return ru.mutation
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (ru *ReviewUpdate) ClearPlan() *ReviewUpdate {
+	ru.mutation.ClearPlan()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReviewUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReviewUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReviewUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReviewUpdate) check() error {
+	if _, ok := ru.mutation.PlanID(); ru.mutation.PlanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Review.plan"`)
+	}
+	return nil
+}
+
+func (ru *ReviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(review.Table, review.Columns, sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Approved(); ok {
+		_spec.SetField(review.FieldApproved, field.TypeBool, value)
+	}
+	if value, ok := ru.mutation.Comment(); ok {
+		_spec.SetField(review.FieldComment, field.TypeString, value)
+	}
+	if ru.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.PlanTable,
+			Columns: []string{review.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.PlanTable,
+			Columns: []string{review.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{review.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReviewUpdateOne is the builder for updating a single Review entity.
+type ReviewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReviewMutation
+}
+
+// SetPlanID sets the "plan_id" field.
+func (ruo *ReviewUpdateOne) SetPlanID(s string) *ReviewUpdateOne {
+	ruo.mutation.SetPlanID(s)
+	return ruo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillablePlanID(s *string) *ReviewUpdateOne {
+	if s != nil {
+		ruo.SetPlanID(*s)
+	}
+	return ruo
+}
+
+// SetApproved sets the "approved" field.
+func (ruo *ReviewUpdateOne) SetApproved(b bool) *ReviewUpdateOne {
+	ruo.mutation.SetApproved(b)
+	return ruo
+}
+
+// SetNillableApproved sets the "approved" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableApproved(b *bool) *ReviewUpdateOne {
+	if b != nil {
+		ruo.SetApproved(*b)
+	}
+	return ruo
+}
+
+// SetComment sets the "comment" field.
+func (ruo *ReviewUpdateOne) SetComment(s string) *ReviewUpdateOne {
+	ruo.mutation.SetComment(s)
+	return ruo
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableComment(s *string) *ReviewUpdateOne {
+	if s != nil {
+		ruo.SetComment(*s)
+	}
+	return ruo
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (ruo *ReviewUpdateOne) SetPlan(p *Plan) *ReviewUpdateOne {
+	return ruo.SetPlanID(p.ID)
+}
+
+// Mutation returns the ReviewMutation object of the builder.
+func (ruo *ReviewUpdateOne) Mutation() *ReviewMutation {
+	return ruo.mutation
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (ruo *ReviewUpdateOne) ClearPlan() *ReviewUpdateOne {
+	ruo.mutation.ClearPlan()
+	return ruo
+}
+
+// Where appends a list predicates to the ReviewUpdate builder.
+func (ruo *ReviewUpdateOne) Where(ps ...predicate.Review) *ReviewUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReviewUpdateOne) Select(field string, fields ...string) *ReviewUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Review entity.
+func (ruo *ReviewUpdateOne) Save(ctx context.Context) (*Review, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReviewUpdateOne) SaveX(ctx context.Context) *Review {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReviewUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReviewUpdateOne) check() error {
+	if _, ok := ruo.mutation.PlanID(); ruo.mutation.PlanCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Review.plan"`)
+	}
+	return nil
+}
+
+func (ruo *ReviewUpdateOne) sqlSave(ctx context.Context) (_node *Review, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(review.Table, review.Columns, sqlgraph.NewFieldSpec(review.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Review.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, review.FieldID)
+		for _, f := range fields {
+			if !review.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != review.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Approved(); ok {
+		_spec.SetField(review.FieldApproved, field.TypeBool, value)
+	}
+	if value, ok := ruo.mutation.Comment(); ok {
+		_spec.SetField(review.FieldComment, field.TypeString, value)
+	}
+	if ruo.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.PlanTable,
+			Columns: []string{review.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.PlanTable,
+			Columns: []string{review.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Review{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{review.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
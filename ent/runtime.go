@@ -2,8 +2,75 @@
 
 package ent
 
+import (
+	"time"
+
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/document"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/review"
+	"github.com/nakamasato/aicoder/ent/schema"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	applyrunFields := schema.ApplyRun{}.Fields()
+	_ = applyrunFields
+	// applyrunDescError is the schema descriptor for error field.
+	applyrunDescError := applyrunFields[4].Descriptor()
+	// applyrun.DefaultError holds the default value on creation for the error field.
+	applyrun.DefaultError = applyrunDescError.Default.(string)
+	// applyrunDescCreatedAt is the schema descriptor for created_at field.
+	applyrunDescCreatedAt := applyrunFields[5].Descriptor()
+	// applyrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	applyrun.DefaultCreatedAt = applyrunDescCreatedAt.Default.(func() time.Time)
+	blockchangeFields := schema.BlockChange{}.Fields()
+	_ = blockchangeFields
+	// blockchangeDescNewContent is the schema descriptor for new_content field.
+	blockchangeDescNewContent := blockchangeFields[6].Descriptor()
+	// blockchange.DefaultNewContent holds the default value on creation for the new_content field.
+	blockchange.DefaultNewContent = blockchangeDescNewContent.Default.(string)
+	// blockchangeDescNewComment is the schema descriptor for new_comment field.
+	blockchangeDescNewComment := blockchangeFields[7].Descriptor()
+	// blockchange.DefaultNewComment holds the default value on creation for the new_comment field.
+	blockchange.DefaultNewComment = blockchangeDescNewComment.Default.(string)
+	// blockchangeDescDecision is the schema descriptor for decision field.
+	blockchangeDescDecision := blockchangeFields[8].Descriptor()
+	// blockchange.DefaultDecision holds the default value on creation for the decision field.
+	blockchange.DefaultDecision = blockchangeDescDecision.Default.(string)
+	documentFields := schema.Document{}.Fields()
+	_ = documentFields
+	// documentDescBlobHash is the schema descriptor for blob_hash field.
+	documentDescBlobHash := documentFields[5].Descriptor()
+	// document.DefaultBlobHash holds the default value on creation for the blob_hash field.
+	document.DefaultBlobHash = documentDescBlobHash.Default.(string)
+	// documentDescEmbeddingModel is the schema descriptor for embedding_model field.
+	documentDescEmbeddingModel := documentFields[6].Descriptor()
+	// document.DefaultEmbeddingModel holds the default value on creation for the embedding_model field.
+	document.DefaultEmbeddingModel = documentDescEmbeddingModel.Default.(string)
+	planFields := schema.Plan{}.Fields()
+	_ = planFields
+	// planDescRepository is the schema descriptor for repository field.
+	planDescRepository := planFields[1].Descriptor()
+	// plan.DefaultRepository holds the default value on creation for the repository field.
+	plan.DefaultRepository = planDescRepository.Default.(string)
+	// planDescCreatedAt is the schema descriptor for created_at field.
+	planDescCreatedAt := planFields[3].Descriptor()
+	// plan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	plan.DefaultCreatedAt = planDescCreatedAt.Default.(func() time.Time)
+	// planDescUpdatedAt is the schema descriptor for updated_at field.
+	planDescUpdatedAt := planFields[4].Descriptor()
+	// plan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	plan.DefaultUpdatedAt = planDescUpdatedAt.Default.(func() time.Time)
+	// plan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	plan.UpdateDefaultUpdatedAt = planDescUpdatedAt.UpdateDefault.(func() time.Time)
+	reviewFields := schema.Review{}.Fields()
+	_ = reviewFields
+	// reviewDescCreatedAt is the schema descriptor for created_at field.
+	reviewDescCreatedAt := reviewFields[3].Descriptor()
+	// review.DefaultCreatedAt holds the default value on creation for the created_at field.
+	review.DefaultCreatedAt = reviewDescCreatedAt.Default.(func() time.Time)
 }
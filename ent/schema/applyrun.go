@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ApplyRun holds the schema definition for the ApplyRun entity.
+type ApplyRun struct {
+	ent.Schema
+}
+
+// Fields of the ApplyRun.
+func (ApplyRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("plan_id"),
+		field.Bool("dryrun"),
+		field.Int("changes"), // the number of changes to apply
+		field.Bool("succeeded"),
+		field.Text("error").Default(""),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ApplyRun.
+func (ApplyRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("plan", Plan.Type).Ref("apply_runs").Field("plan_id").Unique().Required(),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BlockChange holds the schema definition for the BlockChange entity.
+type BlockChange struct {
+	ent.Schema
+}
+
+// Fields of the BlockChange.
+func (BlockChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("plan_id"),
+		field.Int("index"), // the order of the change in the plan
+		field.String("action"),
+		field.Text("path"),
+		field.Text("target_type"),
+		field.Text("target_name"),
+		field.Text("new_content").Default(""),
+		field.Text("new_comment").Default(""),
+		field.Text("decision").Default(""),
+	}
+}
+
+// Edges of the BlockChange.
+func (BlockChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("plan", Plan.Type).Ref("block_changes").Field("plan_id").Unique().Required(),
+	}
+}
+
+func (BlockChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("plan_id", "index").Unique(),
+	}
+}
@@ -22,6 +22,8 @@ func (Document) Fields() []ent.Field {
 		field.Text("context"),
 		field.Text("filepath"),
 		field.Text("description"),
+		field.Text("blob_hash").Default(""),
+		field.Text("embedding_model").Default(""),
 		field.Time("updated_at"),
 		field.Other("embedding", pgvector.Vector{}).
 			SchemaType(map[string]string{
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Plan holds the schema definition for the Plan entity.
+// The ID is the ID of the plan file, which is kept while the plan is revised.
+type Plan struct {
+	ent.Schema
+}
+
+// Fields of the Plan.
+func (Plan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable(),
+		field.Text("repository").Default(""),
+		field.Text("query"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Plan.
+func (Plan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("block_changes", BlockChange.Type),
+		edge.To("reviews", Review.Type),
+		edge.To("apply_runs", ApplyRun.Type),
+	}
+}
+
+func (Plan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("repository", "updated_at"),
+	}
+}
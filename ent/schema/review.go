@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Review holds the schema definition for the Review entity.
+type Review struct {
+	ent.Schema
+}
+
+// Fields of the Review.
+func (Review) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("plan_id"),
+		field.Bool("approved"),
+		field.Text("comment"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Review.
+func (Review) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("plan", Plan.Type).Ref("reviews").Field("plan_id").Unique().Required(),
+	}
+}
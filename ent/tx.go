@@ -12,8 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApplyRun is the client for interacting with the ApplyRun builders.
+	ApplyRun *ApplyRunClient
+	// BlockChange is the client for interacting with the BlockChange builders.
+	BlockChange *BlockChangeClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
+	// Plan is the client for interacting with the Plan builders.
+	Plan *PlanClient
+	// Review is the client for interacting with the Review builders.
+	Review *ReviewClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +153,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApplyRun = NewApplyRunClient(tx.config)
+	tx.BlockChange = NewBlockChangeClient(tx.config)
 	tx.Document = NewDocumentClient(tx.config)
+	tx.Plan = NewPlanClient(tx.config)
+	tx.Review = NewReviewClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Document.QueryXXX(), the query will be executed
+// applies a query, for example: ApplyRun.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
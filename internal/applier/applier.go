@@ -2,6 +2,8 @@ package applier
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -10,110 +12,280 @@ import (
 	"strings"
 
 	"github.com/fatih/color"
+	"github.com/nakamasato/aicoder/internal/file"
 	"github.com/nakamasato/aicoder/internal/planner"
 )
 
-// Applier defines the interface for applying changes to different types of files.
-// Implementations should handle reading from r, applying the change c, and returning
-// the modified content as bytes.
-type Applier interface {
-	Apply(r io.Reader, c planner.BlockChange) ([]byte, error)
+// ErrDrift is returned when the files have been changed since the plan was generated and the changes cannot be applied safely.
+var ErrDrift = errors.New("files have been changed since the plan was generated")
+
+type applyOptions struct {
+	force bool
 }
 
-// ApplyChanges applies changes based on the provided changesPlan.
-// If dryrun is true, it displays the diffs without modifying the actual files.
-func ApplyChanges(changesPlan *planner.ChangesPlan, dryrun bool) error {
+type ApplyOption func(*applyOptions)
 
-	goAplr := &goApplier{}
-	hclAplr := &hclApplier{}
+// WithForce applies the changes even if the target blocks have been changed since the plan was generated.
+func WithForce(force bool) ApplyOption {
+	return func(o *applyOptions) {
+		o.force = force
+	}
+}
 
-	var diffs []string
+// ApplyChanges applies changes based on the provided changesPlan.
+// If dryrun is true, it displays the diffs without modifying the actual files.
+// The changes are not applied if the target blocks have been changed since the plan was generated unless WithForce is specified.
+// The changes made after planning are merged into the changes of the entire files if they don't conflict.
+// The changes rejected with ReviewChanges are skipped.
+func ApplyChanges(changesPlan *planner.ChangesPlan, dryrun bool, opts ...ApplyOption) error {
+	options := &applyOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
 
+	var targets []planner.BlockChange
 	for _, change := range changesPlan.Changes {
-		// Capture the current value of change to avoid closure issues
-		if !isValidFileType(change.Block.Path, change.Block.TargetType) {
-			return fmt.Errorf("unsupported file type: %s", change.Block.Path)
+		if change.Decision != planner.DecisionRejected {
+			targets = append(targets, change)
 		}
+	}
 
-		change := change
-		targetPath := change.Block.Path
-		f, err := os.OpenFile(targetPath, os.O_RDWR, 0644)
+	changes, err := resolveDrifts(targets, options.force)
+	if err != nil {
+		return err
+	}
+
+	if dryrun {
+		diffs, err := previewDiffs(changes)
 		if err != nil {
-			return fmt.Errorf("failed to open file (%s): %w", targetPath, err)
+			return err
 		}
-		defer f.Close()
+		for _, diff := range diffs {
+			printDiff(diff)
+		}
+		return nil
+	}
 
-		var data []byte
-		if filepath.Ext(change.Block.Path) == ".go" {
-			data, err = goAplr.Apply(f, change)
-			if err != nil {
-				return fmt.Errorf("failed to apply change to go file (%s): %w", targetPath, err)
-			}
-		} else if filepath.Ext(change.Block.Path) == ".hcl" || filepath.Ext(change.Block.Path) == ".tf" {
-			data, err = hclAplr.Apply(f, change)
-			if err != nil {
-				return fmt.Errorf("failed to apply change to hcl file (%s): %w", targetPath, err)
-			}
-		} else if change.Block.TargetType == "file" {
-			// Apply change to file
-			data = []byte(change.NewContent)
+	for _, change := range changes {
+		targetPath := change.Block.Path
+		_, data, err := PreviewChange(change)
+		if err != nil {
+			return err
 		}
 
-		if dryrun {
-			// Generate diff
-			originalContent, err := os.ReadFile(change.Block.Path)
-			if err != nil {
-				return fmt.Errorf("failed to read original file (%s): %w", change.Block.Path, err)
-			}
-			diff, err := generateDiff(originalContent, data)
-			if err != nil {
-				return fmt.Errorf("failed to generate diff: %w", err)
+		if change.Block.TargetType == "file" && change.GetAction() == planner.ActionTypeDelete {
+			if err := os.Remove(targetPath); err != nil {
+				return fmt.Errorf("failed to delete file (%s): %w", targetPath, err)
 			}
-			diffs = append(diffs, diff)
 		} else {
-			// Reset the file pointer and truncate the file
-			if _, err := f.Seek(0, io.SeekStart); err != nil {
-				return fmt.Errorf("failed to seek to the beginning of file (%s): %w", targetPath, err)
-			}
-			if err := f.Truncate(0); err != nil {
-				return fmt.Errorf("failed to truncate file (%s): %w", targetPath, err)
+			if err := os.MkdirAll(filepath.Dir(targetPath), 0755); err != nil {
+				return fmt.Errorf("failed to create directory for file (%s): %w", targetPath, err)
 			}
-			if _, err := f.Write(data); err != nil {
+			if err := os.WriteFile(targetPath, data, 0644); err != nil {
 				return fmt.Errorf("failed to write to file (%s): %w", targetPath, err)
 			}
 		}
 	}
 
-	if dryrun {
-		for _, diff := range diffs {
-			scanner := bufio.NewScanner(strings.NewReader(diff))
-			for scanner.Scan() {
-				line := scanner.Text()
-				if strings.HasPrefix(line, "+") {
-					color.New(color.FgGreen).Println(line)
-				} else if strings.HasPrefix(line, "-") {
-					color.New(color.FgRed).Println(line)
-				} else {
-					fmt.Println(line)
-				}
-			}
+	return nil
+}
 
-			if err := scanner.Err(); err != nil {
-				fmt.Printf("Error reading diff output: %v", err)
-			}
+// previewDiffs returns the diff of each change applied in memory in order so that the diffs match the files written by ApplyChanges.
+func previewDiffs(changes []planner.BlockChange) ([]string, error) {
+	var diffs []string
+	var firstErr error
+	PreviewEach(changes, func(i int, before, after []byte, err error) {
+		if firstErr != nil {
+			return
+		}
+		if err != nil {
+			firstErr = err
+			return
+		}
+		diff, err := GenerateDiff(changes[i].Block.Path, before, after)
+		if err != nil {
+			firstErr = fmt.Errorf("failed to generate diff: %w", err)
+			return
+		}
+		diffs = append(diffs, diff)
+	})
+	return diffs, firstErr
+}
+
+// PreviewChange returns the content of the file before and after applying the change without modifying the file.
+// before is nil for a new file and after is nil when the file is deleted.
+func PreviewChange(change planner.BlockChange) (before, after []byte, err error) {
+	return previewChange(change, readFile)
+}
+
+// PreviewChanges applies the changes in order in memory and returns the contents of the changed files without modifying the files.
+// The content of a deleted file is nil. The changes that cannot be applied are skipped and their errors are returned by the index of the change.
+func PreviewChanges(changes []planner.BlockChange) (map[string][]byte, map[int]error) {
+	contents := map[string][]byte{}
+	errs := map[int]error{}
+	PreviewEach(changes, func(i int, before, after []byte, err error) {
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		contents[changes[i].Block.Path] = after
+	})
+	return contents, errs
+}
+
+// PreviewEach applies the changes in order in memory without modifying the files
+// and calls fn with the content of the file before and after each change (see PreviewChange).
+// Each change is applied to the content changed by the preceding changes. The changes that cannot be applied are skipped.
+func PreviewEach(changes []planner.BlockChange, fn func(i int, before, after []byte, err error)) {
+	contents := map[string][]byte{}
+	read := func(path string) ([]byte, bool, error) {
+		if content, ok := contents[path]; ok {
+			return content, content != nil, nil
+		}
+		return readFile(path)
+	}
+	for i, change := range changes {
+		before, after, err := previewChange(change, read)
+		if err == nil {
+			contents[change.Block.Path] = after
+		}
+		fn(i, before, after, err)
+	}
+}
+
+// readFile returns the content of the file and whether the file exists.
+func readFile(path string) ([]byte, bool, error) {
+	if !file.Exists(path) {
+		return nil, false, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+	return content, true, nil
+}
+
+func previewChange(change planner.BlockChange, read func(path string) ([]byte, bool, error)) (before, after []byte, err error) {
+	if !isValidFileType(change.Block.Path, change.Block.TargetType) {
+		return nil, nil, fmt.Errorf("unsupported file type: %s", change.Block.Path)
+	}
+
+	targetPath := change.Block.Path
+	before, exists, err := read(targetPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file (%s): %w", targetPath, err)
+	}
+	if change.Block.TargetType == "file" && change.GetAction() == planner.ActionTypeAdd {
+		// new file
+		if exists {
+			return nil, nil, fmt.Errorf("file already exists (%s)", targetPath)
 		}
+	} else if !exists {
+		return nil, nil, fmt.Errorf("failed to read file (%s): %w", targetPath, os.ErrNotExist)
 	}
 
-	return nil
+	if change.Block.TargetType == "file" {
+		// Apply change to the entire file. after is empty when the file is deleted.
+		if change.GetAction() != planner.ActionTypeDelete {
+			after = []byte(change.NewContent)
+		}
+	} else {
+		lang, ok := planner.LanguageFor(targetPath)
+		if !ok {
+			return nil, nil, fmt.Errorf("no language registered for %s", targetPath)
+		}
+		after, err = lang.Apply(bytes.NewReader(before), change)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to apply change to %s file (%s): %w", lang.Name(), targetPath, err)
+		}
+	}
+	if after != nil {
+		if err := planner.ValidateFile(targetPath, after); err != nil {
+			return nil, nil, fmt.Errorf("failed to validate the changed file (%s): %w", targetPath, err)
+		}
+	}
+	return before, after, nil
 }
 
+// resolveDrifts checks the changes made to the files after planning before changing any file.
+// The changes of the entire files are replaced with the merged contents if the changes don't overlap.
+// The drifts that cannot be resolved are displayed with the diffs and ErrDrift is returned unless force is true.
+func resolveDrifts(changes []planner.BlockChange, force bool) ([]planner.BlockChange, error) {
+	resolved := make([]planner.BlockChange, len(changes))
+	var unresolved int
+	for i, change := range changes {
+		resolved[i] = change
+		drift, err := planner.DetectDrift(change)
+		if err != nil {
+			return nil, fmt.Errorf("failed to detect changes of file (%s): %w", change.Block.Path, err)
+		}
+		if drift == nil {
+			continue
+		}
+		if !drift.BlockChanged {
+			fmt.Printf("Warning: %s\n", drift)
+			continue
+		}
+		merged, conflict, err := planner.MergeDrift(change, drift)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge changes of file (%s): %w", change.Block.Path, err)
+		}
+		if !conflict {
+			fmt.Printf("Warning: %s. The changes are merged into the plan.\n", drift)
+			resolved[i].NewContent = merged
+			continue
+		}
+
+		fmt.Printf("%s (%s %s %s):\n", drift, change.GetAction(), change.Block.TargetType, change.Block.TargetName)
+		diff, err := GenerateDiff(change.Block.Path, []byte(drift.Original), []byte(drift.Current))
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate diff: %w", err)
+		}
+		printDiff(diff)
+		unresolved++
+	}
+	if unresolved > 0 && !force {
+		return nil, fmt.Errorf("%w: %d changes cannot be applied safely. Please regenerate the plan", ErrDrift, unresolved)
+	}
+	return resolved, nil
+}
+
+// printDiff displays the unified diff with colors.
+func printDiff(diff string) {
+	writeDiff(os.Stdout, diff)
+}
+
+// writeDiff writes the unified diff with colors to w.
+func writeDiff(w io.Writer, diff string) {
+	scanner := bufio.NewScanner(strings.NewReader(diff))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "+") {
+			color.New(color.FgGreen).Fprintln(w, line)
+		} else if strings.HasPrefix(line, "-") {
+			color.New(color.FgRed).Fprintln(w, line)
+		} else {
+			fmt.Fprintln(w, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(w, "Error reading diff output: %v", err)
+	}
+}
+
+// isValidFileType returns true if the entire file is changed or a language is registered for the file.
 func isValidFileType(path string, targetType string) bool {
-	ext := filepath.Ext(path)
-	return ext == ".go" || ext == ".hcl" || ext == ".tf" || targetType == "file"
+	if targetType == "file" {
+		return true
+	}
+	_, ok := planner.LanguageFor(path)
+	return ok
 }
 
-// generateDiff generates a unified diff between the original and modified content.
-func generateDiff(original, modified []byte) (string, error) {
+// GenerateDiff generates a unified diff of the file between the original and modified content.
+// The original content is nil for a new file and the modified content is nil for a deleted file.
+func GenerateDiff(path string, original, modified []byte) (string, error) {
 	// Create temporary files for original and modified content
 	originalTempFile, err := os.CreateTemp("", "original-*.tmp")
 	if err != nil {
@@ -139,12 +311,22 @@ func generateDiff(original, modified []byte) (string, error) {
 	originalTempFile.Close()
 	modifiedTempFile.Close()
 
-	// Use diff command on the temporary files
-	cmd := exec.Command("diff", "-u", originalTempFile.Name(), modifiedTempFile.Name())
-	output, err := cmd.CombinedOutput()
+	// Use diff command on the temporary files labeled with the path
+	path = strings.TrimPrefix(path, "/")
+	fromFile, toFile := "a/"+path, "b/"+path
+	if original == nil {
+		fromFile = "/dev/null"
+	}
+	if modified == nil {
+		toFile = "/dev/null"
+	}
+	cmd := exec.Command("diff", "-u", "--label", fromFile, "--label", toFile, originalTempFile.Name(), modifiedTempFile.Name())
+	output, err := cmd.Output()
 
-	if err != nil {
-		fmt.Println("There is diff")
+	// diff exits with 1 if the contents are different
+	var exitErr *exec.ExitError
+	if err != nil && !(errors.As(err, &exitErr) && exitErr.ExitCode() == 1) {
+		return "", fmt.Errorf("failed to run diff: %w", err)
 	}
 	return string(output), nil
 }
@@ -0,0 +1,187 @@
+package applier
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/nakamasato/aicoder/internal/planner"
+)
+
+// Reviser revises the change based on the comment given by the user (e.g. planner.Planner.ReviseBlockChange).
+type Reviser func(ctx context.Context, change planner.BlockChange, comment string) (*planner.BlockChange, error)
+
+// Editor lets the user edit the content and returns the edited content.
+// path is the path to the target file of the change.
+type Editor func(path, content string) (string, error)
+
+type reviewOptions struct {
+	in      io.Reader
+	out     io.Writer
+	editor  Editor
+	reviser Reviser
+}
+
+type ReviewOption func(*reviewOptions)
+
+// WithIO reads the choices from in and writes the changes to out (default: os.Stdin and os.Stdout).
+func WithIO(in io.Reader, out io.Writer) ReviewOption {
+	return func(o *reviewOptions) {
+		o.in = in
+		o.out = out
+	}
+}
+
+// WithEditor sets the Editor to edit the new content (default: EditInEditor).
+func WithEditor(editor Editor) ReviewOption {
+	return func(o *reviewOptions) {
+		o.editor = editor
+	}
+}
+
+// WithReviser enables to revise the change with a comment.
+func WithReviser(reviser Reviser) ReviewOption {
+	return func(o *reviewOptions) {
+		o.reviser = reviser
+	}
+}
+
+// ReviewChanges walks through the undecided changes of the plan with their diffs and records the decision in each change.
+// The user can accept, reject, edit the new content or revise the change with a comment.
+// The changes after quitting are left undecided.
+func ReviewChanges(ctx context.Context, changesPlan *planner.ChangesPlan, opts ...ReviewOption) error {
+	options := &reviewOptions{in: os.Stdin, out: os.Stdout, editor: EditInEditor}
+	for _, opt := range opts {
+		opt(options)
+	}
+	out := options.out
+	reader := bufio.NewReader(options.in)
+
+	for i := range changesPlan.Changes {
+		change := &changesPlan.Changes[i]
+		if change.Decision != "" {
+			continue
+		}
+		for change.Decision == "" {
+			color.New(color.Bold).Fprintf(out, "\n[%d/%d] %s %s %s in %s\n", i+1, len(changesPlan.Changes), change.GetAction(), change.Block.TargetType, change.Block.TargetName, change.Block.Path)
+			previewErr := writeChange(out, *change)
+
+			fmt.Fprint(out, "[a]ccept, [r]eject, [e]dit, re[v]ise with a comment, [q]uit: ")
+			answer, err := readLine(reader)
+			if err != nil {
+				return err
+			}
+			switch answer {
+			case "a", "accept":
+				if previewErr != nil {
+					fmt.Fprintf(out, "The change cannot be applied: %v\n", previewErr)
+					continue
+				}
+				change.Decision = planner.DecisionAccepted
+			case "r", "reject":
+				change.Decision = planner.DecisionRejected
+			case "e", "edit":
+				if change.GetAction() == planner.ActionTypeDelete {
+					fmt.Fprintln(out, "The delete change cannot be edited")
+					continue
+				}
+				content, err := options.editor(change.Block.Path, change.NewContent)
+				if err != nil {
+					fmt.Fprintf(out, "Failed to edit the change: %v\n", err)
+					continue
+				}
+				change.NewContent = content
+			case "v", "revise":
+				if options.reviser == nil {
+					fmt.Fprintln(out, "Revising the change is not available")
+					continue
+				}
+				fmt.Fprint(out, "Comment: ")
+				comment, err := readLine(reader)
+				if err != nil {
+					return err
+				}
+				revised, err := options.reviser(ctx, *change, comment)
+				if err != nil {
+					fmt.Fprintf(out, "Failed to revise the change: %v\n", err)
+					continue
+				}
+				*change = *revised
+				change.Decision = ""
+			case "q", "quit":
+				return nil
+			default:
+				fmt.Fprintf(out, "Unknown choice: %q\n", answer)
+			}
+		}
+	}
+	return nil
+}
+
+// writeChange writes the diff of the change against the current file.
+// The new content is written instead with the error if the change cannot be applied.
+func writeChange(w io.Writer, change planner.BlockChange) error {
+	before, after, err := PreviewChange(change)
+	if err != nil {
+		color.New(color.FgYellow).Fprintf(w, "Cannot show the diff: %v\n", err)
+		fmt.Fprintln(w, change.NewContent)
+		return err
+	}
+	diff, err := GenerateDiff(change.Block.Path, before, after)
+	if err != nil {
+		return fmt.Errorf("failed to generate diff: %w", err)
+	}
+	writeDiff(w, diff)
+	return nil
+}
+
+// readLine reads a line from the reader. io.ErrUnexpectedEOF is returned if the input ends before a choice is made.
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if errors.Is(err, io.EOF) && line == "" {
+		return "", io.ErrUnexpectedEOF
+	} else if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// EditInEditor opens the content in $EDITOR (vi if not set) and returns the edited content.
+// The temporary file has the same extension as path for syntax highlighting.
+func EditInEditor(path, content string) (string, error) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+	tmpFile, err := os.CreateTemp("", "aicoder-*"+filepath.Ext(path))
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.WriteString(content); err != nil {
+		tmpFile.Close()
+		return "", fmt.Errorf("failed to write content to temp file: %w", err)
+	}
+	tmpFile.Close()
+
+	args := append(strings.Fields(editor), tmpFile.Name())
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to run editor (%s): %w", editor, err)
+	}
+	edited, err := os.ReadFile(tmpFile.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited content: %w", err)
+	}
+	return string(edited), nil
+}
@@ -0,0 +1,64 @@
+package applier
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nakamasato/aicoder/internal/planner"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "main.go")
+	if err := os.WriteFile(path, []byte("package main\n\nfunc Old() {}\n\nfunc Other() {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	changesPlan := &planner.ChangesPlan{
+		Query: "Update functions",
+		Changes: []planner.BlockChange{
+			{Block: planner.Block{Path: path, TargetType: "function", TargetName: "Old"}, NewContent: "return"},
+			{Action: planner.ActionTypeAdd, Block: planner.Block{Path: path, TargetType: "function", TargetName: "New"}, NewContent: "func New() {}"},
+			{Block: planner.Block{Path: path, TargetType: "function", TargetName: "Other"}, NewContent: "if {"},
+			{Action: planner.ActionTypeDelete, Block: planner.Block{Path: path, TargetType: "function", TargetName: "Old"}, Decision: planner.DecisionRejected},
+			{Block: planner.Block{Path: path, TargetType: "function", TargetName: "Other"}, NewContent: "return"},
+			{Block: planner.Block{Path: path, TargetType: "function", TargetName: "Other"}, NewContent: "return"},
+		},
+	}
+
+	var revised []string
+	reviser := func(ctx context.Context, change planner.BlockChange, comment string) (*planner.BlockChange, error) {
+		revised = append(revised, comment)
+		change.NewContent = "println(\"revised\")"
+		return &change, nil
+	}
+	editor := func(path, content string) (string, error) {
+		return "return", nil
+	}
+
+	// 1: accept, 2: reject, 3: cannot accept the invalid change then edit and accept,
+	// 4: already decided, 5: revise and accept, 6: quit
+	in := strings.NewReader("a\nr\na\ne\naccept\nx\nv\nplease print\na\nq\n")
+	var out bytes.Buffer
+	err := ReviewChanges(context.Background(), changesPlan, WithIO(in, &out), WithEditor(editor), WithReviser(reviser))
+	assert.NoError(t, err)
+
+	var decisions []planner.Decision
+	for _, change := range changesPlan.Changes {
+		decisions = append(decisions, change.Decision)
+	}
+	assert.Equal(t, []planner.Decision{planner.DecisionAccepted, planner.DecisionRejected, planner.DecisionAccepted, planner.DecisionRejected, planner.DecisionAccepted, ""}, decisions)
+	assert.Equal(t, "return", changesPlan.Changes[2].NewContent)
+	assert.Equal(t, []string{"please print"}, revised)
+	assert.Equal(t, "println(\"revised\")", changesPlan.Changes[4].NewContent)
+	assert.Contains(t, out.String(), "The change cannot be applied")
+	assert.Contains(t, out.String(), "Unknown choice: \"x\"")
+
+	// input ends before all the changes are decided
+	changesPlan.Changes[5].Decision = ""
+	err = ReviewChanges(context.Background(), changesPlan, WithIO(strings.NewReader(""), &out))
+	assert.Error(t, err)
+}
@@ -1,11 +1,15 @@
 package applier
 
 import (
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/nakamasato/aicoder/internal/file"
 	"github.com/nakamasato/aicoder/internal/planner"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestApplyChangesGo(t *testing.T) {
@@ -39,8 +43,7 @@ func Func1() {
 
 // This is a new comment.
 func Func1() {
-	fmt.
-		Println("This is the new content.")
+	fmt.Println("This is the new content.")
 }
 `,
 		},
@@ -208,3 +211,241 @@ func TestApplyChanges_UnsupportedFileType(t *testing.T) {
 		t.Fatalf("expected error: %s, got: %v", expectedError, err)
 	}
 }
+
+func TestApplyChanges_AddAndDelete(t *testing.T) {
+	tempDir := t.TempDir()
+	goFile := filepath.Join(tempDir, "main.go")
+	hclFile := filepath.Join(tempDir, "main.tf")
+	oldFile := filepath.Join(tempDir, "old.md")
+	newFile := filepath.Join(tempDir, "docs", "new.md")
+	files := map[string]string{
+		goFile: `package main
+
+// Old is deprecated.
+func Old() {}
+
+func Keep() {}
+`,
+		hclFile: `resource "google_storage_bucket" "old" {
+  name = "old"
+}
+
+resource "google_storage_bucket" "keep" {
+  name = "keep"
+}
+`,
+		oldFile: "# Old\n",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	changesPlan := &planner.ChangesPlan{
+		Changes: []planner.BlockChange{
+			{Action: planner.ActionTypeDelete, Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Old"}},
+			{Action: planner.ActionTypeAdd, Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "New"}, NewContent: "// New is new.\nfunc New() {}"},
+			{Action: planner.ActionTypeDelete, Block: planner.Block{Path: hclFile, TargetType: "resource", TargetName: "google_storage_bucket,old"}},
+			{Action: planner.ActionTypeAdd, Block: planner.Block{Path: hclFile, TargetType: "resource", TargetName: "google_storage_bucket,new"}, NewContent: "resource \"google_storage_bucket\" \"new\" {\n  name = \"new\"\n}\n"},
+			{Action: planner.ActionTypeDelete, Block: planner.Block{Path: oldFile, TargetType: "file", TargetName: oldFile}},
+			{Action: planner.ActionTypeAdd, Block: planner.Block{Path: newFile, TargetType: "file", TargetName: newFile}, NewContent: "# New\n"},
+		},
+	}
+
+	// dry run doesn't change anything
+	assert.NoError(t, ApplyChanges(changesPlan, true))
+	assert.NoFileExists(t, newFile)
+	assert.FileExists(t, oldFile)
+
+	assert.NoError(t, ApplyChanges(changesPlan, false))
+
+	content, err := os.ReadFile(goFile)
+	assert.NoError(t, err)
+	assert.Equal(t, `package main
+
+func Keep() {}
+
+// New is new.
+func New() {}
+`, string(content))
+
+	content, err = os.ReadFile(hclFile)
+	assert.NoError(t, err)
+	assert.Equal(t, `resource "google_storage_bucket" "keep" {
+  name = "keep"
+}
+
+resource "google_storage_bucket" "new" {
+  name = "new"
+}
+`, string(content))
+
+	assert.NoFileExists(t, oldFile)
+	content, err = os.ReadFile(newFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "# New\n", string(content))
+
+	// adding an existing file fails
+	err = ApplyChanges(&planner.ChangesPlan{Changes: changesPlan.Changes[5:]}, false)
+	assert.ErrorContains(t, err, "file already exists")
+}
+
+// lineLanguage replaces the line starting with the target name instead of the HCL block.
+type lineLanguage struct {
+	planner.Language
+}
+
+func (lineLanguage) Apply(r io.Reader, c planner.BlockChange) ([]byte, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(src), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, c.Block.TargetName+":") {
+			lines[i] = c.NewContent
+		}
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+func TestApplyChanges_RegisteredLanguage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.tf")
+	if err := os.WriteFile(path, []byte("name: old\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	changesPlan := &planner.ChangesPlan{
+		Changes: []planner.BlockChange{
+			{Block: planner.Block{Path: path, TargetType: "key", TargetName: "name"}, NewContent: "name = \"new\""},
+		},
+	}
+
+	hcl, _ := planner.LanguageFor(path)
+	planner.RegisterLanguage(lineLanguage{Language: hcl})
+	defer planner.RegisterLanguage(hcl)
+
+	assert.NoError(t, ApplyChanges(changesPlan, false))
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "name = \"new\"\n", string(content))
+
+	// the changed file is validated by the language
+	changesPlan.Changes[0].NewContent = "name: invalid"
+	if err := os.WriteFile(path, []byte("name: old\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	assert.ErrorContains(t, ApplyChanges(changesPlan, false), "failed to validate the changed file")
+}
+
+func TestPreviewDiffs(t *testing.T) {
+	goFile := filepath.Join(t.TempDir(), "main.go")
+	if err := os.WriteFile(goFile, []byte("package main\n\nfunc Foo() {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	changes := []planner.BlockChange{
+		{Action: planner.ActionTypeAdd, Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Bar"}, NewContent: "func Bar() {}"},
+		{Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Bar"}, NewContent: "Foo()"},
+	}
+
+	// the second change is applied to the function added by the first change
+	diffs, err := previewDiffs(changes)
+	assert.NoError(t, err)
+	if assert.Len(t, diffs, 2) {
+		assert.Contains(t, diffs[0], "+func Bar() {}\n")
+		assert.Contains(t, diffs[1], "-func Bar() {}\n+func Bar() {\n+\tFoo()\n+}\n")
+	}
+
+	_, err = previewDiffs([]planner.BlockChange{{Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Unknown"}, NewContent: "return"}})
+	assert.ErrorContains(t, err, "function Unknown not found")
+}
+
+func TestApplyChanges_Drift(t *testing.T) {
+	tempDir := t.TempDir()
+	goFile := filepath.Join(tempDir, "main.go")
+	mdFile := filepath.Join(tempDir, "README.md")
+	goContent := "package main\n\nfunc Foo() {}\n\nfunc Bar() {}\n"
+	mdContent := "# Title\n\nDescription\n\n## Usage\n\nRun it.\n"
+
+	// plan generated for the original files
+	newPlan := func() *planner.ChangesPlan {
+		return &planner.ChangesPlan{
+			Changes: []planner.BlockChange{
+				{Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Foo", Content: "func Foo() {}"}, NewContent: "return", FileHash: file.Hash([]byte(goContent))},
+				{Block: planner.Block{Path: mdFile, TargetType: "file", TargetName: mdFile, Content: mdContent}, NewContent: "# Title\n\nDescription\n\n## Usage\n\nRun it with --help.\n", FileHash: file.Hash([]byte(mdContent))},
+			},
+		}
+	}
+	writeFiles := func(goContent, mdContent string) {
+		if err := os.WriteFile(goFile, []byte(goContent), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+		if err := os.WriteFile(mdFile, []byte(mdContent), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	// the other parts of the files are changed after planning
+	writeFiles("package main\n\nfunc Foo() {}\n\nfunc Bar() { return }\n", "# New Title\n\nDescription\n\n## Usage\n\nRun it.\n")
+	assert.NoError(t, ApplyChanges(newPlan(), false))
+	content, err := os.ReadFile(goFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "package main\n\nfunc Foo() {\n\treturn\n}\n\nfunc Bar() { return }\n", string(content))
+	content, err = os.ReadFile(mdFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "# New Title\n\nDescription\n\n## Usage\n\nRun it with --help.\n", string(content)) // merged
+
+	// the target blocks are changed after planning
+	writeFiles("package main\n\nfunc Foo() { println() }\n\nfunc Bar() {}\n", "# Title\n\nDescription\n\n## Usage\n\nRun it now.\n")
+	err = ApplyChanges(newPlan(), false)
+	assert.ErrorIs(t, err, ErrDrift)
+	assert.ErrorContains(t, err, "2 changes cannot be applied safely")
+	content, err = os.ReadFile(goFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "package main\n\nfunc Foo() { println() }\n\nfunc Bar() {}\n", string(content)) // not changed
+
+	// force
+	assert.NoError(t, ApplyChanges(newPlan(), false, WithForce(true)))
+	content, err = os.ReadFile(mdFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "# Title\n\nDescription\n\n## Usage\n\nRun it with --help.\n", string(content))
+}
+
+func TestPreviewChanges(t *testing.T) {
+	tempDir := t.TempDir()
+	goFile := filepath.Join(tempDir, "main.go")
+	newFile := filepath.Join(tempDir, "new.go")
+	oldFile := filepath.Join(tempDir, "old.md")
+	original := "package main\n\nfunc Old() {}\n"
+	for path, content := range map[string]string{goFile: original, oldFile: "# Old\n"} {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	contents, errs := PreviewChanges([]planner.BlockChange{
+		{Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Old"}, NewContent: "println(\"old\")"},
+		{Action: planner.ActionTypeAdd, Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "New"}, NewContent: "func New() {}"},
+		{Action: planner.ActionTypeAdd, Block: planner.Block{Path: newFile, TargetType: "file", TargetName: newFile}, NewContent: "package main\n"},
+		{Action: planner.ActionTypeAdd, Block: planner.Block{Path: newFile, TargetType: "function", TargetName: "Added"}, NewContent: "func Added() {}"},
+		{Action: planner.ActionTypeDelete, Block: planner.Block{Path: oldFile, TargetType: "file", TargetName: oldFile}},
+		{Block: planner.Block{Path: oldFile, TargetType: "file", TargetName: oldFile}, NewContent: "# Deleted\n"},
+		{Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Unknown"}, NewContent: "return"},
+	})
+
+	assert.Equal(t, map[string][]byte{
+		goFile:  []byte("package main\n\nfunc Old() {\n\tprintln(\"old\")\n}\n\nfunc New() {}\n"),
+		newFile: []byte("package main\n\nfunc Added() {}\n"),
+		oldFile: nil,
+	}, contents)
+	assert.Len(t, errs, 2)
+	assert.ErrorContains(t, errs[5], "failed to read file")
+	assert.Error(t, errs[6])
+
+	// the files are not changed
+	content, err := os.ReadFile(goFile)
+	assert.NoError(t, err)
+	assert.Equal(t, original, string(content))
+	assert.NoFileExists(t, newFile)
+	assert.FileExists(t, oldFile)
+}
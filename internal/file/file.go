@@ -2,9 +2,12 @@ package file
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -90,6 +93,18 @@ func ReadContent(path string) (string, error) {
 	return string(data), nil
 }
 
+// CleanPath cleans the path relative to the root of the repository.
+// An error is returned if the path is absolute or outside of the repository (e.g. ../secret).
+func CleanPath(path string) (string, error) {
+	if filepath.IsAbs(path) {
+		return "", fmt.Errorf("absolute path is not allowed: %s", path)
+	}
+	if !filepath.IsLocal(path) {
+		return "", fmt.Errorf("path outside of the repository is not allowed: %s", path)
+	}
+	return filepath.Clean(path), nil
+}
+
 // Exists checks if a file exists at the given path.
 func Exists(path string) bool {
 	_, err := os.Stat(path)
@@ -99,6 +114,23 @@ func Exists(path string) bool {
 	return err == nil
 }
 
+// Hash returns the SHA-256 hash of the content in hex.
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// HashFile returns the hash of the content of the file. Empty string is returned if the file doesn't exist.
+func HashFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to read file %s: %w", path, err)
+	}
+	return Hash(data), nil
+}
+
 type FileReader interface {
 	ReadContent(path string) (string, error)
 }
@@ -2,6 +2,7 @@ package file_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/nakamasato/aicoder/internal/file"
@@ -138,3 +139,47 @@ func TestExists(t *testing.T) {
 		t.Errorf("Expected file to not exist, but it does")
 	}
 }
+
+func TestHashFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.txt")
+	hash, err := file.HashFile(path)
+	if err != nil || hash != "" {
+		t.Errorf("expected empty hash for non-existent file, got %q, %v", hash, err)
+	}
+
+	if err := os.WriteFile(path, []byte("hello"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	hash, err = file.HashFile(path)
+	if err != nil {
+		t.Fatalf("HashFile failed: %v", err)
+	}
+	expected := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if hash != expected || file.Hash([]byte("hello")) != expected {
+		t.Errorf("expected %q, got %q", expected, hash)
+	}
+}
+
+func TestCleanPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{path: "internal/file/file.go", want: "internal/file/file.go"},
+		{path: "./internal/../cmd/main.go", want: "cmd/main.go"},
+		{path: "/etc/passwd", wantErr: true},
+		{path: "../secret", wantErr: true},
+		{path: "internal/../../secret", wantErr: true},
+		{path: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := file.CleanPath(tt.path)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CleanPath(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("CleanPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package file
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// Types of Go blocks.
+const (
+	GoBlockFunction  = "function"
+	GoBlockMethod    = "method"
+	GoBlockStruct    = "struct"
+	GoBlockInterface = "interface"
+	GoBlockType      = "type" // other type specs e.g. type Kind string
+	GoBlockConst     = "const"
+	GoBlockVar       = "var"
+	GoBlockImport    = "import"
+)
+
+// GoBlock is a top-level declaration in a Go file that can be changed as a unit.
+//   - function: Name is the function name
+//   - method: Name is qualified with the receiver type e.g. Client.Search
+//   - struct, interface, type: Name is the type name
+//   - const, var: the entire declaration (group). Name is the names joined with ","
+//   - import: the entire import declaration. Name is "import"
+//
+// The blocks with the same type and name in a file (e.g. import declarations, init functions and var _ declarations)
+// are numbered in the order of appearance to be distinguished e.g. init#1 and init#2.
+type GoBlock struct {
+	Type      string
+	Name      string
+	Content   string
+	StartLine int
+	EndLine   int
+
+	// Start and End are the byte offsets of Content.
+	Start int
+	End   int
+	// DocStart is the byte offset of the doc comment. It's equal to Start if there's no doc comment.
+	DocStart int
+	// Grouped is true for a type spec in a grouped declaration e.g. type ( A int; B string ).
+	// Content of a grouped type spec doesn't have the "type" keyword.
+	Grouped bool
+}
+
+// ParseGoBlocks parses the Go file and returns the top-level blocks in the order of appearance.
+func ParseGoBlocks(path string) ([]GoBlock, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return ParseGoBlocksFromSource(src)
+}
+
+// ParseGoBlocksFromSource parses the Go source and returns the top-level blocks in the order of appearance.
+func ParseGoBlocksFromSource(src []byte) ([]GoBlock, error) {
+	fs := token.NewFileSet()
+	node, err := parser.ParseFile(fs, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Go source: %w", err)
+	}
+
+	newBlock := func(blockType, name string, doc *ast.CommentGroup, start, end token.Pos) GoBlock {
+		b := GoBlock{
+			Type:      blockType,
+			Name:      name,
+			Start:     fs.Position(start).Offset,
+			End:       fs.Position(end).Offset,
+			StartLine: fs.Position(start).Line,
+			EndLine:   fs.Position(end).Line,
+		}
+		b.Content = string(src[b.Start:b.End])
+		b.DocStart = b.Start
+		if doc != nil {
+			b.DocStart = fs.Position(doc.Pos()).Offset
+		}
+		return b
+	}
+
+	var blocks []GoBlock
+	for _, decl := range node.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if recv := ReceiverName(d); recv != "" {
+				blocks = append(blocks, newBlock(GoBlockMethod, recv+"."+d.Name.Name, d.Doc, d.Pos(), d.End()))
+			} else {
+				blocks = append(blocks, newBlock(GoBlockFunction, d.Name.Name, d.Doc, d.Pos(), d.End()))
+			}
+		case *ast.GenDecl:
+			switch d.Tok {
+			case token.IMPORT:
+				blocks = append(blocks, newBlock(GoBlockImport, "import", d.Doc, d.Pos(), d.End()))
+			case token.CONST, token.VAR:
+				var names []string
+				for _, spec := range d.Specs {
+					for _, name := range spec.(*ast.ValueSpec).Names {
+						names = append(names, name.Name)
+					}
+				}
+				blocks = append(blocks, newBlock(d.Tok.String(), strings.Join(names, ","), d.Doc, d.Pos(), d.End()))
+			case token.TYPE:
+				for _, spec := range d.Specs {
+					ts := spec.(*ast.TypeSpec)
+					blockType := GoBlockType
+					switch ts.Type.(type) {
+					case *ast.StructType:
+						blockType = GoBlockStruct
+					case *ast.InterfaceType:
+						blockType = GoBlockInterface
+					}
+					if d.Lparen.IsValid() {
+						b := newBlock(blockType, ts.Name.Name, ts.Doc, ts.Pos(), ts.End())
+						b.Grouped = true
+						blocks = append(blocks, b)
+					} else {
+						blocks = append(blocks, newBlock(blockType, ts.Name.Name, d.Doc, d.Pos(), d.End()))
+					}
+				}
+			}
+		}
+	}
+
+	count := map[string]int{}
+	for _, b := range blocks {
+		count[b.Type+"\x00"+b.Name]++
+	}
+	seen := map[string]int{}
+	for i, b := range blocks {
+		key := b.Type + "\x00" + b.Name
+		if count[key] > 1 {
+			seen[key]++
+			blocks[i].Name = fmt.Sprintf("%s#%d", b.Name, seen[key])
+		}
+	}
+	return blocks, nil
+}
+
+// FindGoBlock finds the block with the type and the name.
+// A function type also matches a method for backward compatibility with the plans that didn't have method type.
+// An error is returned if the block is not found or the function name matches the methods of more than one type.
+func FindGoBlock(blocks []GoBlock, blockType, name string) (GoBlock, error) {
+	for _, b := range blocks {
+		if b.Type == blockType && b.Name == name {
+			return b, nil
+		}
+	}
+	if blockType == GoBlockFunction {
+		var methods []GoBlock
+		for _, b := range blocks {
+			if b.Type == GoBlockMethod && b.Name[strings.LastIndex(b.Name, ".")+1:] == name {
+				methods = append(methods, b)
+			}
+		}
+		switch len(methods) {
+		case 0:
+		case 1:
+			return methods[0], nil
+		default:
+			names := make([]string, len(methods))
+			for i, m := range methods {
+				names[i] = m.Name
+			}
+			return GoBlock{}, fmt.Errorf("function %s is ambiguous: it matches the methods %s", name, strings.Join(names, ", "))
+		}
+	}
+	return GoBlock{}, fmt.Errorf("%s %s not found", blockType, name)
+}
+
+// ReceiverName returns the type name of the receiver of the method or empty string for functions.
+func ReceiverName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	expr := fn.Recv.List[0].Type
+	for {
+		switch t := expr.(type) {
+		case *ast.StarExpr:
+			expr = t.X
+		case *ast.IndexExpr: // generic type with one type parameter
+			expr = t.X
+		case *ast.IndexListExpr: // generic type with multiple type parameters
+			expr = t.X
+		case *ast.Ident:
+			return t.Name
+		default:
+			return ""
+		}
+	}
+}
+
+// GoFuncName returns the name of the function qualified with the receiver type for methods e.g. Client.Search.
+func GoFuncName(fn *ast.FuncDecl) string {
+	if recv := ReceiverName(fn); recv != "" {
+		return recv + "." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
@@ -0,0 +1,119 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGoBlocksFromSource(t *testing.T) {
+	src := `package main
+
+import "fmt"
+
+// Kind is a kind.
+type Kind string
+
+const (
+	KindA Kind = "a"
+	KindB Kind = "b"
+)
+
+var x = 1
+
+type (
+	Client struct{}
+	Store  interface{ Search() }
+)
+
+// Search searches.
+func (c *Client) Search() { fmt.Println() }
+
+func Search() {}
+`
+	blocks, err := ParseGoBlocksFromSource([]byte(src))
+	assert.NoError(t, err)
+
+	var got [][2]string
+	for _, b := range blocks {
+		got = append(got, [2]string{b.Type, b.Name})
+	}
+	assert.Equal(t, [][2]string{
+		{GoBlockImport, "import"},
+		{GoBlockType, "Kind"},
+		{GoBlockConst, "KindA,KindB"},
+		{GoBlockVar, "x"},
+		{GoBlockStruct, "Client"},
+		{GoBlockInterface, "Store"},
+		{GoBlockMethod, "Client.Search"},
+		{GoBlockFunction, "Search"},
+	}, got)
+
+	kind, err := FindGoBlock(blocks, GoBlockType, "Kind")
+	assert.NoError(t, err)
+	assert.Equal(t, "type Kind string", kind.Content)
+	assert.Equal(t, "// Kind is a kind.\ntype Kind string", src[kind.DocStart:kind.End])
+
+	client, err := FindGoBlock(blocks, GoBlockStruct, "Client")
+	assert.NoError(t, err)
+	assert.True(t, client.Grouped)
+	assert.Equal(t, "Client struct{}", client.Content)
+
+	// method and function with the same name don't collide
+	method, err := FindGoBlock(blocks, GoBlockMethod, "Client.Search")
+	assert.NoError(t, err)
+	assert.Equal(t, 21, method.StartLine)
+	fn, err := FindGoBlock(blocks, GoBlockFunction, "Search")
+	assert.NoError(t, err)
+	assert.Equal(t, "func Search() {}", fn.Content)
+
+	_, err = FindGoBlock(blocks, GoBlockFunction, "Unknown")
+	assert.EqualError(t, err, "function Unknown not found")
+}
+
+func TestFindGoBlock_Method(t *testing.T) {
+	blocks, err := ParseGoBlocksFromSource([]byte(`package main
+
+func (c *Client) Search() {}
+
+func (s *Store) Search() {}
+
+func (c *Client) Get() {}
+`))
+	assert.NoError(t, err)
+
+	// a function type matches the only method with the name
+	get, err := FindGoBlock(blocks, GoBlockFunction, "Get")
+	assert.NoError(t, err)
+	assert.Equal(t, "Client.Get", get.Name)
+
+	// but not the methods of more than one type
+	_, err = FindGoBlock(blocks, GoBlockFunction, "Search")
+	assert.EqualError(t, err, "function Search is ambiguous: it matches the methods Client.Search, Store.Search")
+}
+
+func TestParseGoBlocksFromSource_Duplicates(t *testing.T) {
+	blocks, err := ParseGoBlocksFromSource([]byte(`package main
+
+import "fmt"
+
+import "os"
+
+func init() { fmt.Println() }
+
+func init() { os.Exit(0) }
+
+func main() {}
+`))
+	assert.NoError(t, err)
+
+	var names []string
+	for _, b := range blocks {
+		names = append(names, b.Name)
+	}
+	assert.Equal(t, []string{"import#1", "import#2", "init#1", "init#2", "main"}, names)
+
+	init2, err := FindGoBlock(blocks, GoBlockFunction, "init#2")
+	assert.NoError(t, err)
+	assert.Equal(t, "func init() { os.Exit(0) }", init2.Content)
+}
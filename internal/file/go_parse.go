@@ -7,6 +7,7 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 type Function struct {
@@ -83,3 +84,13 @@ func ParseGo(path string) ([]Function, []Var, error) {
 
 	return functions, variables, nil
 }
+
+// IsGoTestFile returns true if the path is a Go test file.
+func IsGoTestFile(path string) bool {
+	return strings.HasSuffix(path, "_test.go")
+}
+
+// GoTestFileFor returns the conventional test file path of the Go source file (e.g. applier.go -> applier_test.go).
+func GoTestFileFor(path string) string {
+	return strings.TrimSuffix(path, ".go") + "_test.go"
+}
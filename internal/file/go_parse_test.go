@@ -70,3 +70,22 @@ func TestParseGo(t *testing.T) {
 		}
 	}
 }
+
+func TestGoTestFile(t *testing.T) {
+	tests := []struct {
+		path   string
+		isTest bool
+		test   string
+	}{
+		{path: "internal/applier/go_applier.go", isTest: false, test: "internal/applier/go_applier_test.go"},
+		{path: "internal/applier/go_applier_test.go", isTest: true, test: "internal/applier/go_applier_test_test.go"},
+	}
+	for _, tt := range tests {
+		if got := IsGoTestFile(tt.path); got != tt.isTest {
+			t.Errorf("IsGoTestFile(%s) = %v, want %v", tt.path, got, tt.isTest)
+		}
+		if got := GoTestFileFor(tt.path); got != tt.test {
+			t.Errorf("GoTestFileFor(%s) = %s, want %s", tt.path, got, tt.test)
+		}
+	}
+}
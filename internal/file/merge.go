@@ -0,0 +1,38 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// Merge3 merges the changes from base to other into current with `git merge-file`.
+// conflict is true if the changes overlap. The merged content has the conflict markers in that case.
+func Merge3(base, current, other []byte) (merged []byte, conflict bool, err error) {
+	var paths []string
+	for _, content := range [][]byte{current, base, other} {
+		f, err := os.CreateTemp("", "merge-*.tmp")
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create temp file: %w", err)
+		}
+		defer os.Remove(f.Name()) // Clean up
+		if _, err := f.Write(content); err != nil {
+			f.Close()
+			return nil, false, fmt.Errorf("failed to write temp file: %w", err)
+		}
+		f.Close()
+		paths = append(paths, f.Name())
+	}
+
+	cmd := exec.Command("git", "merge-file", "-p", "-L", "current", "-L", "base", "-L", "plan", paths[0], paths[1], paths[2])
+	output, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 && exitErr.ExitCode() < 128 {
+		// the exit code is the number of conflicts
+		return output, true, nil
+	} else if err != nil {
+		return nil, false, fmt.Errorf("failed to merge: %w", err)
+	}
+	return output, false, nil
+}
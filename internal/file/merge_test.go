@@ -0,0 +1,33 @@
+package file_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nakamasato/aicoder/internal/file"
+)
+
+func TestMerge3(t *testing.T) {
+	base := "a\nb\nc\nd\ne\n"
+	current := "a\nB\nc\nd\ne\n" // changed after planning
+	other := "a\nb\nc\nd\nE\n"   // changed by the plan
+
+	merged, conflict, err := file.Merge3([]byte(base), []byte(current), []byte(other))
+	if err != nil {
+		t.Fatalf("Merge3 failed: %v", err)
+	}
+	if conflict {
+		t.Errorf("expected no conflict")
+	}
+	if string(merged) != "a\nB\nc\nd\nE\n" {
+		t.Errorf("unexpected merged content: %q", merged)
+	}
+
+	merged, conflict, err = file.Merge3([]byte(base), []byte(current), []byte("a\nX\nc\nd\ne\n"))
+	if err != nil {
+		t.Fatalf("Merge3 failed: %v", err)
+	}
+	if !conflict || !strings.Contains(string(merged), "<<<<<<< current") {
+		t.Errorf("expected conflict, got %q", merged)
+	}
+}
@@ -0,0 +1,194 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+const (
+	defaultMaxCommits        = 500
+	defaultMaxFilesPerCommit = 30
+)
+
+// CoChange holds how often files are changed together in the commit history.
+type CoChange struct {
+	commits map[string]int            // path -> number of commits that changed the file
+	pairs   map[string]map[string]int // path -> co-changed path -> number of commits that changed both
+}
+
+// Neighbour is a file that is changed together with another file.
+type Neighbour struct {
+	Path  string
+	Count int // number of commits that changed both files
+	// Confidence is Count divided by the number of commits that changed the original file.
+	Confidence float64
+}
+
+type coChangeOptions struct {
+	maxCommits        int
+	since             time.Time
+	maxFilesPerCommit int
+}
+
+type CoChangeOption func(*coChangeOptions)
+
+// WithMaxCommits sets the number of commits from HEAD to mine.
+func WithMaxCommits(n int) CoChangeOption {
+	return func(o *coChangeOptions) {
+		o.maxCommits = n
+	}
+}
+
+// WithSince ignores the commits older than t.
+func WithSince(t time.Time) CoChangeOption {
+	return func(o *coChangeOptions) {
+		o.since = t
+	}
+}
+
+// WithMaxFilesPerCommit ignores the commits that change more files than n (e.g. bulk renames or formatting)
+// as they don't tell which files are related.
+func WithMaxFilesPerCommit(n int) CoChangeOption {
+	return func(o *coChangeOptions) {
+		o.maxFilesPerCommit = n
+	}
+}
+
+// NewCoChange creates CoChange from the sets of files changed by each commit.
+func NewCoChange(changeSets ...[]string) *CoChange {
+	c := &CoChange{commits: map[string]int{}, pairs: map[string]map[string]int{}}
+	for _, paths := range changeSets {
+		c.add(paths)
+	}
+	return c
+}
+
+// LoadCoChange mines the commit history of the git repository at repoPath from HEAD.
+// Merge commits are skipped.
+func LoadCoChange(repoPath string, opts ...CoChangeOption) (*CoChange, error) {
+	o := coChangeOptions{maxCommits: defaultMaxCommits, maxFilesPerCommit: defaultMaxFilesPerCommit}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	gitRepo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository: %w", err)
+	}
+	logOpts := &git.LogOptions{}
+	if !o.since.IsZero() {
+		logOpts.Since = &o.since
+	}
+	iter, err := gitRepo.Log(logOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit log: %w", err)
+	}
+	defer iter.Close()
+
+	c := NewCoChange()
+	n := 0
+	err = iter.ForEach(func(commit *object.Commit) error {
+		if o.maxCommits > 0 && n >= o.maxCommits {
+			return storer.ErrStop
+		}
+		n++
+		if commit.NumParents() > 1 {
+			return nil
+		}
+		paths, err := changedFiles(commit)
+		if err != nil {
+			return err
+		}
+		if o.maxFilesPerCommit > 0 && len(paths) > o.maxFilesPerCommit {
+			return nil
+		}
+		c.add(paths)
+		return nil
+	})
+	if err != nil && !errors.Is(err, storer.ErrStop) {
+		return nil, fmt.Errorf("failed to read commit history: %w", err)
+	}
+	return c, nil
+}
+
+// changedFiles returns the paths changed by the commit compared to its parent.
+func changedFiles(commit *object.Commit) ([]string, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree of %s: %w", commit.Hash, err)
+	}
+	var parentTree *object.Tree
+	if commit.NumParents() == 1 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent of %s: %w", commit.Hash, err)
+		}
+		if parentTree, err = parent.Tree(); err != nil {
+			return nil, fmt.Errorf("failed to get tree of %s: %w", parent.Hash, err)
+		}
+	}
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s: %w", commit.Hash, err)
+	}
+	pathMap := make(map[string]bool)
+	var paths []string
+	for _, change := range changes {
+		for _, name := range []string{change.From.Name, change.To.Name} {
+			if name != "" && !pathMap[name] {
+				pathMap[name] = true
+				paths = append(paths, name)
+			}
+		}
+	}
+	return paths, nil
+}
+
+func (c *CoChange) add(paths []string) {
+	for _, p := range paths {
+		c.commits[p]++
+		for _, q := range paths {
+			if p == q {
+				continue
+			}
+			if c.pairs[p] == nil {
+				c.pairs[p] = map[string]int{}
+			}
+			c.pairs[p][q]++
+		}
+	}
+}
+
+// Commits returns the number of commits that changed the file.
+func (c *CoChange) Commits(path string) int {
+	return c.commits[path]
+}
+
+// Neighbours returns the files changed together with the file at least minCount times
+// ordered by the count and then the path.
+func (c *CoChange) Neighbours(path string, minCount int) []Neighbour {
+	var neighbours []Neighbour
+	for q, count := range c.pairs[path] {
+		if count < minCount {
+			continue
+		}
+		neighbours = append(neighbours, Neighbour{
+			Path:       q,
+			Count:      count,
+			Confidence: float64(count) / float64(c.commits[path]),
+		})
+	}
+	sort.Slice(neighbours, func(i, j int) bool {
+		if neighbours[i].Count != neighbours[j].Count {
+			return neighbours[i].Count > neighbours[j].Count
+		}
+		return neighbours[i].Path < neighbours[j].Path
+	})
+	return neighbours
+}
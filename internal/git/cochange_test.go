@@ -0,0 +1,63 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func commitFiles(t *testing.T, dir string, wt *git.Worktree, msg string, paths ...string) {
+	t.Helper()
+	for _, p := range paths {
+		full := filepath.Join(dir, p)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+		// append so that every commit changes the file
+		f, err := os.OpenFile(full, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		assert.NoError(t, err)
+		_, err = f.WriteString(msg + "\n")
+		assert.NoError(t, err)
+		assert.NoError(t, f.Close())
+		_, err = wt.Add(p)
+		assert.NoError(t, err)
+	}
+	_, err := wt.Commit(msg, &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	assert.NoError(t, err)
+}
+
+func TestLoadCoChange(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+
+	commitFiles(t, dir, wt, "init", "cmd/plan/cmd.go", "internal/planner/planner.go", "README.md")
+	commitFiles(t, dir, wt, "plan flag", "cmd/plan/cmd.go", "internal/planner/planner.go")
+	commitFiles(t, dir, wt, "plan doc", "cmd/plan/cmd.go", "README.md")
+	commitFiles(t, dir, wt, "planner", "internal/planner/planner.go")
+	commitFiles(t, dir, wt, "huge", "a.go", "b.go", "c.go", "cmd/plan/cmd.go")
+
+	c, err := LoadCoChange(dir, WithMaxFilesPerCommit(3))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, c.Commits("cmd/plan/cmd.go")) // huge commit is ignored
+	assert.Equal(t, []Neighbour{
+		{Path: "README.md", Count: 2, Confidence: 2.0 / 3},
+		{Path: "internal/planner/planner.go", Count: 2, Confidence: 2.0 / 3},
+	}, c.Neighbours("cmd/plan/cmd.go", 1))
+	assert.Empty(t, c.Neighbours("cmd/plan/cmd.go", 3))
+	assert.Empty(t, c.Neighbours("a.go", 1))
+
+	// only the latest 2 commits
+	c, err = LoadCoChange(dir, WithMaxCommits(2), WithMaxFilesPerCommit(3))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, c.Commits("cmd/plan/cmd.go"))
+	assert.Equal(t, 1, c.Commits("internal/planner/planner.go"))
+}
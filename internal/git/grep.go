@@ -0,0 +1,46 @@
+package git
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// GrepMatch is a line of a file in the HEAD tree matching the pattern.
+type GrepMatch struct {
+	Path    string
+	Line    int
+	Content string
+}
+
+func (m GrepMatch) String() string {
+	return fmt.Sprintf("%s:%d:%s", m.Path, m.Line, m.Content)
+}
+
+// Grep searches the files committed at HEAD of the git repository at repoPath for the regular expression.
+// Only the files under pathPrefix are searched if it's not empty.
+// The uncommitted changes in the working tree are not searched.
+func Grep(repoPath, pattern, pathPrefix string) ([]GrepMatch, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	gitRepo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository: %w", err)
+	}
+	opts := &git.GrepOptions{Patterns: []*regexp.Regexp{re}}
+	if pathPrefix != "" {
+		opts.PathSpecs = []*regexp.Regexp{regexp.MustCompile("^" + regexp.QuoteMeta(pathPrefix))}
+	}
+	results, err := gitRepo.Grep(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to grep %q: %w", pattern, err)
+	}
+	matches := make([]GrepMatch, len(results))
+	for i, r := range results {
+		matches[i] = GrepMatch{Path: r.FileName, Line: r.LineNumber, Content: r.Content}
+	}
+	return matches, nil
+}
@@ -0,0 +1,32 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrep(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+	commitFiles(t, dir, wt, "func NewClient()", "internal/client.go", "cmd/main.go")
+
+	matches, err := Grep(dir, `New[A-Z]\w+`, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []GrepMatch{
+		{Path: "cmd/main.go", Line: 1, Content: "func NewClient()"},
+		{Path: "internal/client.go", Line: 1, Content: "func NewClient()"},
+	}, matches)
+
+	matches, err = Grep(dir, "NewClient", "internal/")
+	assert.NoError(t, err)
+	assert.Equal(t, []GrepMatch{{Path: "internal/client.go", Line: 1, Content: "func NewClient()"}}, matches)
+	assert.Equal(t, "internal/client.go:1:func NewClient()", matches[0].String())
+
+	_, err = Grep(dir, "(", "")
+	assert.Error(t, err)
+}
@@ -35,3 +35,17 @@ func GetRepoInfo() (owner, repo string, err error) {
 	repo = parts[len(parts)-1]
 	return owner, repo, nil
 }
+
+// GetHeadCommit gets the hash of the HEAD commit of the git repository in the current directory
+func GetHeadCommit() (string, error) {
+	gitRepo, err := git.PlainOpen(".")
+	if err != nil {
+		return "", fmt.Errorf("failed to open git repository: %v", err)
+	}
+
+	ref, err := gitRepo.Head()
+	if err != nil {
+		return "", fmt.Errorf("failed to get HEAD: %v", err)
+	}
+	return ref.Hash().String(), nil
+}
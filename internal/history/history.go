@@ -0,0 +1,191 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/plan"
+	"github.com/nakamasato/aicoder/ent/review"
+	"github.com/nakamasato/aicoder/internal/planner"
+	"github.com/nakamasato/aicoder/internal/reviewer"
+)
+
+// PlanHistory is a recorded plan with its reviews and apply runs.
+type PlanHistory struct {
+	Id         string
+	Repository string
+	Query      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Changes    []*ent.BlockChange
+	Reviews    []*ent.Review   // oldest first
+	ApplyRuns  []*ent.ApplyRun // oldest first
+}
+
+// LastReview returns the latest review of the plan. nil if the plan has not been reviewed.
+func (h PlanHistory) LastReview() *ent.Review {
+	if len(h.Reviews) == 0 {
+		return nil
+	}
+	return h.Reviews[len(h.Reviews)-1]
+}
+
+// LastApplyRun returns the latest apply run of the plan. nil if the plan has not been applied.
+func (h PlanHistory) LastApplyRun() *ent.ApplyRun {
+	if len(h.ApplyRuns) == 0 {
+		return nil
+	}
+	return h.ApplyRuns[len(h.ApplyRuns)-1]
+}
+
+// RecordPlan saves the plan and its changes.
+// The changes recorded for the same plan ID before (e.g. the previous revision of the plan) are replaced.
+func RecordPlan(ctx context.Context, entClient *ent.Client, repository string, p *planner.ChangesPlan) error {
+	return withTx(ctx, entClient, func(tx *ent.Tx) error {
+		return recordPlan(ctx, tx, repository, p)
+	})
+}
+
+// RecordReview saves the review result of the plan together with the plan.
+func RecordReview(ctx context.Context, entClient *ent.Client, repository string, p *planner.ChangesPlan, r *reviewer.ReviewResult) error {
+	return withTx(ctx, entClient, func(tx *ent.Tx) error {
+		if err := recordPlan(ctx, tx, repository, p); err != nil {
+			return err
+		}
+		if err := tx.Review.Create().SetPlanID(p.Id).SetApproved(r.Approved).SetComment(r.Comment).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to save review: %w", err)
+		}
+		return nil
+	})
+}
+
+// RecordApplyRun saves the result of applying the plan together with the plan (e.g. the decisions made with apply --interactive).
+// changes is the number of changes to apply and applyErr is the error returned by applying the changes.
+func RecordApplyRun(ctx context.Context, entClient *ent.Client, repository string, p *planner.ChangesPlan, dryrun bool, changes int, applyErr error) error {
+	return withTx(ctx, entClient, func(tx *ent.Tx) error {
+		if err := recordPlan(ctx, tx, repository, p); err != nil {
+			return err
+		}
+		create := tx.ApplyRun.Create().
+			SetPlanID(p.Id).
+			SetDryrun(dryrun).
+			SetChanges(changes).
+			SetSucceeded(applyErr == nil)
+		if applyErr != nil {
+			create.SetError(applyErr.Error())
+		}
+		if err := create.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to save apply run: %w", err)
+		}
+		return nil
+	})
+}
+
+func recordPlan(ctx context.Context, tx *ent.Tx, repository string, p *planner.ChangesPlan) error {
+	if p.Id == "" {
+		return fmt.Errorf("plan ID is empty")
+	}
+	err := tx.Plan.Create().
+		SetID(p.Id).
+		SetRepository(repository).
+		SetQuery(p.Query).
+		OnConflictColumns(plan.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save plan (%s): %w", p.Id, err)
+	}
+
+	if _, err := tx.BlockChange.Delete().Where(blockchange.PlanIDEQ(p.Id)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete changes of plan (%s): %w", p.Id, err)
+	}
+	builders := make([]*ent.BlockChangeCreate, len(p.Changes))
+	for i, c := range p.Changes {
+		builders[i] = tx.BlockChange.Create().
+			SetPlanID(p.Id).
+			SetIndex(i).
+			SetAction(string(c.GetAction())).
+			SetPath(c.Block.Path).
+			SetTargetType(c.Block.TargetType).
+			SetTargetName(c.Block.TargetName).
+			SetNewContent(c.NewContent).
+			SetNewComment(c.NewComment).
+			SetDecision(string(c.Decision))
+	}
+	if err := tx.BlockChange.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save changes of plan (%s): %w", p.Id, err)
+	}
+	return nil
+}
+
+// List returns the recorded plans of the repository, most recently updated first.
+// All the repositories are included if repository is empty. limit <= 0 means no limit.
+func List(ctx context.Context, entClient *ent.Client, repository string, limit int) ([]PlanHistory, error) {
+	query := withEdges(entClient.Plan.Query()).Order(ent.Desc(plan.FieldUpdatedAt))
+	if repository != "" {
+		query = query.Where(plan.RepositoryEQ(repository))
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	plans, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	histories := make([]PlanHistory, len(plans))
+	for i, p := range plans {
+		histories[i] = toPlanHistory(p)
+	}
+	return histories, nil
+}
+
+// Get returns the recorded plan with the ID.
+func Get(ctx context.Context, entClient *ent.Client, id string) (*PlanHistory, error) {
+	p, err := withEdges(entClient.Plan.Query()).Where(plan.IDEQ(id)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan (%s): %w", id, err)
+	}
+	h := toPlanHistory(p)
+	return &h, nil
+}
+
+func withEdges(query *ent.PlanQuery) *ent.PlanQuery {
+	return query.
+		WithBlockChanges(func(q *ent.BlockChangeQuery) { q.Order(ent.Asc(blockchange.FieldIndex)) }).
+		WithReviews(func(q *ent.ReviewQuery) { q.Order(ent.Asc(review.FieldCreatedAt)) }).
+		WithApplyRuns(func(q *ent.ApplyRunQuery) { q.Order(ent.Asc(applyrun.FieldCreatedAt)) })
+}
+
+func toPlanHistory(p *ent.Plan) PlanHistory {
+	return PlanHistory{
+		Id:         p.ID,
+		Repository: p.Repository,
+		Query:      p.Query,
+		CreatedAt:  p.CreatedAt,
+		UpdatedAt:  p.UpdatedAt,
+		Changes:    p.Edges.BlockChanges,
+		Reviews:    p.Edges.Reviews,
+		ApplyRuns:  p.Edges.ApplyRuns,
+	}
+}
+
+func withTx(ctx context.Context, entClient *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: failed to rollback: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
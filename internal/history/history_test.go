@@ -0,0 +1,94 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/nakamasato/aicoder/ent/applyrun"
+	"github.com/nakamasato/aicoder/ent/blockchange"
+	"github.com/nakamasato/aicoder/ent/review"
+	"github.com/nakamasato/aicoder/internal/planner"
+	"github.com/nakamasato/aicoder/internal/reviewer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanHistory_Last(t *testing.T) {
+	h := PlanHistory{}
+	assert.Nil(t, h.LastReview())
+	assert.Nil(t, h.LastApplyRun())
+
+	h.Reviews = []*ent.Review{{Comment: "first"}, {Comment: "second"}}
+	h.ApplyRuns = []*ent.ApplyRun{{Changes: 1}}
+	assert.Equal(t, "second", h.LastReview().Comment)
+	assert.Equal(t, 1, h.LastApplyRun().Changes)
+}
+
+func TestRecord(t *testing.T) {
+	ctx := context.Background()
+
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	entClient, err := ent.Open("postgres", dbURL)
+	if err != nil {
+		t.Fatalf("failed opening connection to postgres: %v", err)
+	}
+	defer entClient.Close()
+	if err := entClient.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	p := &planner.ChangesPlan{
+		Id:    uuid.New().String(),
+		Query: "test query",
+		Changes: []planner.BlockChange{
+			{Block: planner.Block{Path: "main.go", TargetType: "function", TargetName: "main"}, NewContent: "return"},
+			{Action: planner.ActionTypeAdd, Block: planner.Block{Path: "new.go", TargetType: "file"}, NewContent: "package main\n"},
+		},
+	}
+	defer cleanUp(t, entClient, p.Id)
+
+	assert.NoError(t, RecordPlan(ctx, entClient, "test-repository", p))
+	assert.NoError(t, RecordReview(ctx, entClient, "test-repository", p, &reviewer.ReviewResult{PlanId: p.Id, Approved: false, Comment: "add tests"}))
+
+	// the revised plan replaces the changes
+	p.Changes = p.Changes[:1]
+	p.Changes[0].Decision = planner.DecisionAccepted
+	assert.NoError(t, RecordApplyRun(ctx, entClient, "test-repository", p, false, 1, errors.New("failed to write")))
+
+	h, err := Get(ctx, entClient, p.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "test query", h.Query)
+	assert.Len(t, h.Changes, 1)
+	assert.Equal(t, "accepted", h.Changes[0].Decision)
+	assert.Equal(t, "add tests", h.LastReview().Comment)
+	assert.False(t, h.LastApplyRun().Succeeded)
+	assert.Equal(t, "failed to write", h.LastApplyRun().Error)
+
+	histories, err := List(ctx, entClient, "test-repository", 1)
+	assert.NoError(t, err)
+	assert.Len(t, histories, 1)
+	assert.Equal(t, p.Id, histories[0].Id)
+}
+
+func cleanUp(t *testing.T, entClient *ent.Client, id string) {
+	ctx := context.Background()
+	if _, err := entClient.BlockChange.Delete().Where(blockchange.PlanIDEQ(id)).Exec(ctx); err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+	if _, err := entClient.Review.Delete().Where(review.PlanIDEQ(id)).Exec(ctx); err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+	if _, err := entClient.ApplyRun.Delete().Where(applyrun.PlanIDEQ(id)).Exec(ctx); err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+	if err := entClient.Plan.DeleteOneID(id).Exec(ctx); err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+}
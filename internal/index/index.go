@@ -0,0 +1,149 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/nakamasato/aicoder/ent/document"
+)
+
+// ContextStats is the statistics of the documents for a repository and context.
+type ContextStats struct {
+	Repository     string    `json:"repository"`
+	Context        string    `json:"context"`
+	EmbeddingModel string    `json:"embedding_model"`
+	Count          int       `json:"count"`
+	LastUpdatedAt  time.Time `json:"last_updated_at"`
+}
+
+// Stats returns the number of documents and the last update time per repository, context and embedding model.
+func Stats(ctx context.Context, entClient *ent.Client) ([]ContextStats, error) {
+	var rows []struct {
+		Repository     string    `json:"repository"`
+		Context        string    `json:"context"`
+		EmbeddingModel string    `json:"embedding_model"`
+		Count          int       `json:"count"`
+		Max            time.Time `json:"max"`
+	}
+	err := entClient.Document.Query().
+		GroupBy(document.FieldRepository, document.FieldContext, document.FieldEmbeddingModel).
+		Aggregate(ent.Count(), ent.Max(document.FieldUpdatedAt)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate documents: %w", err)
+	}
+
+	stats := make([]ContextStats, 0, len(rows))
+	for _, r := range rows {
+		stats = append(stats, ContextStats{
+			Repository:     r.Repository,
+			Context:        r.Context,
+			EmbeddingModel: r.EmbeddingModel,
+			Count:          r.Count,
+			LastUpdatedAt:  r.Max,
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Repository != stats[j].Repository {
+			return stats[i].Repository < stats[j].Repository
+		}
+		if stats[i].Context != stats[j].Context {
+			return stats[i].Context < stats[j].Context
+		}
+		return stats[i].EmbeddingModel < stats[j].EmbeddingModel
+	})
+	return stats, nil
+}
+
+// Staleness is the result of comparing the documents with the files in a git tree.
+type Staleness struct {
+	UpToDate  int      // blob hash matches
+	Stale     []string // blob hash differs
+	Unknown   []string // document has no blob hash (loaded before blob hashes were recorded)
+	Deleted   []string // document exists but the file doesn't
+	NotLoaded []string // file exists but the document doesn't
+}
+
+// CheckStaleness compares the documents with the files (path to blob hash) in HEAD.
+func CheckStaleness(docs []*ent.Document, headBlobs map[string]string) Staleness {
+	var s Staleness
+	loaded := make(map[string]bool, len(docs))
+	for _, doc := range docs {
+		loaded[doc.Filepath] = true
+		blobHash, ok := headBlobs[doc.Filepath]
+		switch {
+		case !ok:
+			s.Deleted = append(s.Deleted, doc.Filepath)
+		case doc.BlobHash == "":
+			s.Unknown = append(s.Unknown, doc.Filepath)
+		case doc.BlobHash != blobHash:
+			s.Stale = append(s.Stale, doc.Filepath)
+		default:
+			s.UpToDate++
+		}
+	}
+	for path := range headBlobs {
+		if !loaded[path] {
+			s.NotLoaded = append(s.NotLoaded, path)
+		}
+	}
+	sort.Strings(s.Stale)
+	sort.Strings(s.Unknown)
+	sort.Strings(s.Deleted)
+	sort.Strings(s.NotLoaded)
+	return s
+}
+
+// ListDocuments lists the documents of the repository and context whose file path starts with the prefix.
+func ListDocuments(ctx context.Context, entClient *ent.Client, repository, context, prefix string) ([]*ent.Document, error) {
+	query := entClient.Document.Query().
+		Where(document.RepositoryEQ(repository), document.ContextEQ(context))
+	if prefix != "" {
+		query = query.Where(document.FilepathHasPrefix(prefix))
+	}
+	docs, err := query.
+		Select(document.FieldFilepath, document.FieldDescription, document.FieldBlobHash, document.FieldEmbeddingModel, document.FieldUpdatedAt).
+		Order(ent.Asc(document.FieldFilepath)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query documents: %w", err)
+	}
+	return docs, nil
+}
+
+// UnknownContexts returns the number of documents per context of the repository that is not in the given contexts.
+func UnknownContexts(ctx context.Context, entClient *ent.Client, repository string, contexts []string) (map[string]int, error) {
+	var rows []struct {
+		Context string `json:"context"`
+		Count   int    `json:"count"`
+	}
+	err := entClient.Document.Query().
+		Where(document.RepositoryEQ(repository), document.ContextNotIn(contexts...)).
+		GroupBy(document.FieldContext).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate documents: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, r := range rows {
+		counts[r.Context] = r.Count
+	}
+	return counts, nil
+}
+
+// Prune deletes the documents of the repository whose context is not in the given contexts.
+// It returns the number of deleted documents.
+func Prune(ctx context.Context, entClient *ent.Client, repository string, contexts []string) (int, error) {
+	n, err := entClient.Document.Delete().
+		Where(document.RepositoryEQ(repository), document.ContextNotIn(contexts...)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete documents: %w", err)
+	}
+	return n, nil
+}
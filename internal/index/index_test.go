@@ -0,0 +1,31 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckStaleness(t *testing.T) {
+	docs := []*ent.Document{
+		{Filepath: "main.go", BlobHash: "hash1"},
+		{Filepath: "cmd/root.go", BlobHash: "old"},
+		{Filepath: "cmd/load/cmd.go", BlobHash: ""},
+		{Filepath: "deleted.go", BlobHash: "hash4"},
+	}
+	headBlobs := map[string]string{
+		"main.go":         "hash1",
+		"cmd/root.go":     "hash2",
+		"cmd/load/cmd.go": "hash3",
+		"new.go":          "hash5",
+	}
+
+	staleness := CheckStaleness(docs, headBlobs)
+
+	assert.Equal(t, 1, staleness.UpToDate)
+	assert.Equal(t, []string{"cmd/root.go"}, staleness.Stale)
+	assert.Equal(t, []string{"cmd/load/cmd.go"}, staleness.Unknown)
+	assert.Equal(t, []string{"deleted.go"}, staleness.Deleted)
+	assert.Equal(t, []string{"new.go"}, staleness.NotLoaded)
+}
@@ -10,6 +10,8 @@ type Client interface {
 	GenerateCompletionSimple(ctx context.Context, messages []Message) (string, error)
 	GenerateFunctionCalling(ctx context.Context, messages []Message, tools []Tool) ([]ToolCall, error)
 	GetEmbedding(ctx context.Context, content string) ([]float32, error)
+	GetEmbeddings(ctx context.Context, contents []string) ([][]float32, error)
+	EmbeddingModel() string
 }
 
 type Tool struct {
@@ -28,8 +30,9 @@ type ToolCall struct {
 type Role string
 
 const (
-	RoleSystem Role = "system"
-	RoleUser   Role = "user"
+	RoleSystem    Role = "system"
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
 )
 
 type Message struct {
@@ -39,3 +39,19 @@ func (d DummyClient) GetEmbedding(ctx context.Context, content string) ([]float3
 	}
 	return embedding, nil
 }
+
+func (d DummyClient) GetEmbeddings(ctx context.Context, contents []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(contents))
+	for i, content := range contents {
+		embedding, err := d.GetEmbedding(ctx, content)
+		if err != nil {
+			return nil, err
+		}
+		embeddings[i] = embedding
+	}
+	return embeddings, nil
+}
+
+func (d DummyClient) EmbeddingModel() string {
+	return "dummy"
+}
@@ -84,3 +84,22 @@ func TestGetEmbedding(t *testing.T) {
 		}
 	}
 }
+
+func TestGetEmbeddings(t *testing.T) {
+	client := llm.DummyClient{}
+	ctx := context.Background()
+	contents := []string{"content 1", "content 2", "content 3"}
+
+	embeddings, err := client.GetEmbeddings(ctx, contents)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(embeddings) != len(contents) {
+		t.Fatalf("expected %d embeddings, got %d", len(contents), len(embeddings))
+	}
+	for _, embedding := range embeddings {
+		if len(embedding) != 1536 {
+			t.Errorf("expected embedding of length 1536, got %d", len(embedding))
+		}
+	}
+}
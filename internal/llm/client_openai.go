@@ -14,6 +14,9 @@ var (
 	embeddingModel = openai.EmbeddingModelTextEmbedding3Small
 )
 
+// maxEmbeddingInputs is the maximum number of inputs accepted by a single embeddings request.
+const maxEmbeddingInputs = 2048
+
 type openaiClient struct {
 	openai         *openai.Client
 	chatModel      openai.ChatModel
@@ -105,6 +108,8 @@ func (c openaiClient) convertMessages(messages []Message) []openai.ChatCompletio
 			msgs[i] = openai.SystemMessage(m.Content)
 		} else if m.Role == RoleUser {
 			msgs[i] = openai.UserMessage(m.Content)
+		} else if m.Role == RoleAssistant {
+			msgs[i] = openai.AssistantMessage(m.Content)
 		} else {
 			msgs[i] = openai.SystemMessage(m.Content)
 		}
@@ -173,6 +178,11 @@ func (c openaiClient) GenerateFunctionCalling(ctx context.Context, messages []Me
 	return calls, nil
 }
 
+// EmbeddingModel returns the name of the model used for embeddings.
+func (c openaiClient) EmbeddingModel() string {
+	return string(c.embeddingModel)
+}
+
 // getEmbedding fetches the embedding for a given content using OpenAI.
 func (c openaiClient) GetEmbedding(ctx context.Context, content string) ([]float32, error) {
 	if len(content) == 0 {
@@ -198,3 +208,42 @@ func (c openaiClient) GetEmbedding(ctx context.Context, content string) ([]float
 
 	return embedding, nil
 }
+
+// GetEmbeddings fetches the embeddings for the given contents using OpenAI.
+// The contents are sent as arrays so that a large number of contents only takes a few requests.
+// The returned embeddings are in the same order as the contents.
+func (c openaiClient) GetEmbeddings(ctx context.Context, contents []string) ([][]float32, error) {
+	for i, content := range contents {
+		if len(content) == 0 {
+			return nil, fmt.Errorf("content[%d] is empty", i)
+		}
+	}
+
+	embeddings := make([][]float32, len(contents))
+	for start := 0; start < len(contents); start += maxEmbeddingInputs {
+		end := min(start+maxEmbeddingInputs, len(contents))
+		resp, err := c.openai.Embeddings.New(ctx, openai.EmbeddingNewParams{
+			Model: openai.F(c.embeddingModel),
+			Input: openai.F(openai.EmbeddingNewParamsInputUnion(openai.EmbeddingNewParamsInputArrayOfStrings(contents[start:end]))),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create embeddings: %w", err)
+		}
+		if len(resp.Data) != end-start {
+			return nil, fmt.Errorf("unexpected number of embeddings: got %d, want %d", len(resp.Data), end-start)
+		}
+
+		for _, data := range resp.Data {
+			if data.Index < 0 || int(data.Index) >= end-start {
+				return nil, fmt.Errorf("unexpected embedding index: %d", data.Index)
+			}
+			embedding := make([]float32, len(data.Embedding))
+			for i, v := range data.Embedding {
+				embedding[i] = float32(v)
+			}
+			embeddings[start+int(data.Index)] = embedding
+		}
+	}
+
+	return embeddings, nil
+}
@@ -3,6 +3,7 @@ package loader
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -71,7 +72,21 @@ func (s *service) UpdateDocuments(ctx context.Context) error {
 		document.FilepathNotIn(filePaths...),
 	).ExecX(ctx)
 
+	// fetch the existing documents in one query
+	existingDocs, err := s.entClient.Document.Query().
+		Where(document.RepositoryEQ(s.config.Repository), document.ContextEQ(s.config.CurrentContext)).
+		Select(document.FieldFilepath, document.FieldBlobHash, document.FieldUpdatedAt).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query documents: %w", err)
+	}
+	docMap := make(map[string]*ent.Document, len(existingDocs))
+	for _, doc := range existingDocs {
+		docMap[doc.Filepath] = doc
+	}
+
 	var wg sync.WaitGroup
+	writer := newDocumentWriter(ctx, s.vectorstore, vectorstore.DefaultBatchSize)
 	var errChan = make(chan error, s.structure.Root.Size)
 	loadCfg := s.config.GetCurrentLoadConfig()
 	fmt.Printf("found %d files", s.structure.Root.Size)
@@ -93,17 +108,11 @@ func (s *service) UpdateDocuments(ctx context.Context) error {
 				return
 			}
 
-			doc, err := s.entClient.Document.Query().Where(document.RepositoryEQ(s.config.Repository), document.FilepathEQ(fileinfo.Path), document.ContextEQ(s.config.CurrentContext)).First(ctx)
-			if err == nil && doc.UpdatedAt.After(fileinfo.ModifiedAt) {
+			if doc, ok := docMap[fileinfo.Path]; ok && isUpToDate(doc, fileinfo) {
 				fmt.Printf("Document %s is up-to-date\n", fileinfo.Path)
 				return
 			}
 
-			if err != nil && !ent.IsNotFound(err) {
-				errChan <- fmt.Errorf("failed to query document %s: %v", fileinfo.Path, err)
-				return
-			}
-
 			if string(buf) == "" {
 				fmt.Printf("File is empty: %s\n", fileinfo.Path)
 				return
@@ -118,19 +127,14 @@ func (s *service) UpdateDocuments(ctx context.Context) error {
 				fmt.Printf("Summary is empty: %s\ncontent:%s", fileinfo.Path, string(buf))
 				return
 			}
-			vsDoc := &vectorstore.Document{
+
+			writer.Add(&vectorstore.Document{
 				Repository:  s.config.Repository,
 				Context:     s.config.CurrentContext,
 				Filepath:    fileinfo.Path,
 				Description: summary,
-			}
-
-			err = s.vectorstore.AddDocument(ctx, vsDoc)
-			if err != nil {
-				errChan <- fmt.Errorf("Failed to add vectorstore document %s: %v", fileinfo.Path, err)
-				return
-			}
-			fmt.Printf("upserted document %s\n", fileinfo.Path)
+				BlobHash:    fileinfo.BlobHash,
+			})
 		}(fileinfo)
 	}
 	wg.Wait()
@@ -141,9 +145,80 @@ func (s *service) UpdateDocuments(ctx context.Context) error {
 			fmt.Printf("Error: %v\n", err)
 		}
 	}
+
+	upserted, err := writer.Close()
+	fmt.Printf("upserted %d documents\n", upserted)
+	if err != nil {
+		return fmt.Errorf("failed to add vectorstore documents: %w", err)
+	}
 	return nil
 }
 
+// documentWriter embeds and upserts the documents in batches while the files are being summarized
+// so that a failed batch doesn't discard the summaries of the other batches.
+type documentWriter struct {
+	store     vectorstore.VectorStore
+	batchSize int
+	docs      chan *vectorstore.Document
+	done      chan struct{}
+	upserted  int
+	errs      []error
+}
+
+func newDocumentWriter(ctx context.Context, store vectorstore.VectorStore, batchSize int) *documentWriter {
+	w := &documentWriter{
+		store:     store,
+		batchSize: batchSize,
+		docs:      make(chan *vectorstore.Document),
+		done:      make(chan struct{}),
+	}
+	go func() {
+		defer close(w.done)
+		var batch []*vectorstore.Document
+		flush := func() {
+			if len(batch) == 0 {
+				return
+			}
+			if err := w.store.AddDocuments(ctx, batch); err != nil {
+				w.errs = append(w.errs, err)
+			} else {
+				w.upserted += len(batch)
+			}
+			batch = nil
+		}
+		for doc := range w.docs {
+			batch = append(batch, doc)
+			if len(batch) >= w.batchSize {
+				flush()
+			}
+		}
+		flush()
+	}()
+	return w
+}
+
+// Add queues the document. It's safe to call Add concurrently.
+func (w *documentWriter) Add(doc *vectorstore.Document) {
+	w.docs <- doc
+}
+
+// Close flushes the remaining documents and returns the number of the upserted documents and the errors of the failed batches.
+func (w *documentWriter) Close() (int, error) {
+	close(w.docs)
+	<-w.done
+	return w.upserted, errors.Join(w.errs...)
+}
+
+// isUpToDate checks if the document is up-to-date with the file.
+// The blob hash is compared if both have it so that documents imported from a snapshot are reused
+// regardless of the modification time of the local file.
+func isUpToDate(doc *ent.Document, fileInfo FileInfo) bool {
+	if doc.BlobHash != "" && fileInfo.BlobHash != "" {
+		return doc.BlobHash == fileInfo.BlobHash
+	}
+	return doc.UpdatedAt.After(fileInfo.ModifiedAt)
+}
+
 type FileInfo struct {
 	Name        string     `json:"name"`
 	Path        string     `json:"path"`
@@ -194,6 +269,17 @@ func buildTreeString(builder *strings.Builder, fileInfo FileInfo, prefix string,
 	}
 }
 
+// BlobHashes returns the git blob hash of each file in the repository structure keyed by the file path.
+func (rs *RepoStructure) BlobHashes() map[string]string {
+	blobs := make(map[string]string)
+	for fileInfo := range rs.Root.FileInfoGenerator() {
+		if !fileInfo.IsDir {
+			blobs[fileInfo.Path] = fileInfo.BlobHash
+		}
+	}
+	return blobs
+}
+
 // FilePathGenerator generates file paths from the FileInfo structure.
 func (f *FileInfo) FileInfoGenerator() <-chan FileInfo {
 	ch := make(chan FileInfo)
@@ -2,6 +2,7 @@ package loader
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/nakamasato/aicoder/internal/vectorstore"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -119,6 +121,23 @@ func TestLoadRepoStructure(t *testing.T) {
 	}
 }
 
+func TestBlobHashes(t *testing.T) {
+	repoStructure := &RepoStructure{
+		Root: FileInfo{
+			Name:  "root",
+			IsDir: true,
+			Children: []FileInfo{
+				{Path: "file1.go", BlobHash: "hash1"},
+				{Path: "dir", IsDir: true, Children: []FileInfo{
+					{Path: "dir/file2.go", BlobHash: "hash2"},
+				}},
+			},
+		},
+	}
+
+	assert.Equal(t, map[string]string{"file1.go": "hash1", "dir/file2.go": "hash2"}, repoStructure.BlobHashes())
+}
+
 func TestToTreeString(t *testing.T) {
 	// Setup a sample RepoStructure
 	root := FileInfo{
@@ -202,3 +221,40 @@ func TestMatchesPath(t *testing.T) {
 	// Assertions
 	assert.True(t, result)
 }
+
+// batchStore records the batches and fails the batch containing the document with the failing path.
+type batchStore struct {
+	vectorstore.VectorStore
+	failingPath string
+	batches     [][]string
+}
+
+func (s *batchStore) AddDocuments(ctx context.Context, docs []*vectorstore.Document) error {
+	var paths []string
+	for _, doc := range docs {
+		if doc.Filepath == s.failingPath {
+			return errors.New("failed to embed")
+		}
+		paths = append(paths, doc.Filepath)
+	}
+	s.batches = append(s.batches, paths)
+	return nil
+}
+
+func TestDocumentWriter(t *testing.T) {
+	store := &batchStore{failingPath: "c.go"}
+	writer := newDocumentWriter(context.Background(), store, 2)
+	for _, path := range []string{"a.go", "b.go", "c.go", "d.go", "e.go"} {
+		writer.Add(&vectorstore.Document{Filepath: path})
+	}
+	upserted, err := writer.Close()
+
+	// the batches are flushed as the documents are added and the failed batch doesn't discard the others
+	assert.Equal(t, 3, upserted)
+	assert.Equal(t, [][]string{{"a.go", "b.go"}, {"e.go"}}, store.batches)
+	assert.ErrorContains(t, err, "failed to embed")
+
+	upserted, err = newDocumentWriter(context.Background(), store, 2).Close()
+	assert.Equal(t, 0, upserted)
+	assert.NoError(t, err)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/nakamasato/aicoder/internal/file"
 	"github.com/nakamasato/aicoder/internal/llm"
 	"github.com/nakamasato/aicoder/internal/loader"
+	"github.com/nakamasato/aicoder/internal/prompt"
+	"github.com/nakamasato/aicoder/internal/vectorstore"
 )
 
 //go:embed templates/locator_file.tmpl
@@ -29,16 +31,65 @@ var promptLocateLineTemplate string
 //go:embed templates/file_content.tmpl
 var fileContentTemplate string
 
+// locateFileData is the data passed to the templates to locate files.
+type locateFileData struct {
+	Query         string
+	RepoStructure string
+}
+
+// locateContentsData is the data passed to the templates to locate blocks and lines in the file contents.
+type locateContentsData struct {
+	Query        string
+	FileContents string
+}
+
+func init() {
+	for name, text := range map[string]string{
+		"locator_file.tmpl":            promptLocateFileTemplate,
+		"locator_file_irrelevant.tmpl": promptLocateFileIrrelevantTemplate,
+	} {
+		prompt.RegisterTemplate(prompt.Spec{Name: name, Default: text, Data: locateFileData{}})
+	}
+	for name, text := range map[string]string{
+		"locator_block.tmpl": promptLocateBlockTemplate,
+		"locator_line.tmpl":  promptLocateLineTemplate,
+	} {
+		prompt.RegisterTemplate(prompt.Spec{Name: name, Default: text, Data: locateContentsData{}})
+	}
+	prompt.RegisterTemplate(prompt.Spec{Name: "file_content.tmpl", Default: fileContentTemplate, Data: map[string]string{"path": "content"}})
+}
+
 type Locator struct {
 	config    *config.AICoderConfig
 	llmClient llm.Client
+	searcher  Searcher
+	prompts   *prompt.Loader
 }
 
-func NewLocator(llmClient llm.Client, config *config.AICoderConfig) *Locator {
-	return &Locator{
+// Searcher searches for documents relevant to the query (e.g. retriever.VectorestoreRetriever).
+type Searcher interface {
+	Search(ctx context.Context, query string) ([]vectorstore.DocumentWithScore, error)
+}
+
+type LocatorOption func(*Locator)
+
+// WithSearcher adds the files found by the searcher to the files located by LLM.
+func WithSearcher(searcher Searcher) LocatorOption {
+	return func(l *Locator) {
+		l.searcher = searcher
+	}
+}
+
+func NewLocator(llmClient llm.Client, config *config.AICoderConfig, opts ...LocatorOption) *Locator {
+	l := &Locator{
 		config:    config,
 		llmClient: llmClient,
+		prompts:   prompt.NewLoader(config.Prompts.Dir),
+	}
+	for _, opt := range opts {
+		opt(l)
 	}
+	return l
 }
 
 type LocatorType string // implement pflag.Value
@@ -71,11 +122,12 @@ func (lt *LocatorType) String() string {
 	return string(*lt)
 }
 
+// locatorTypeMap maps the LocatorType to the name of the prompt template (see prompt.Loader).
 var locatorTypeMap = map[LocatorType]string{
-	LocatorTypeFile:           promptLocateFileTemplate,
-	LocatorTypeFileIrrelevant: promptLocateFileIrrelevantTemplate,
-	LocatorTypeBlock:          promptLocateBlockTemplate,
-	LocatorTypeLine:           promptLocateLineTemplate,
+	LocatorTypeFile:           "locator_file.tmpl",
+	LocatorTypeFileIrrelevant: "locator_file_irrelevant.tmpl",
+	LocatorTypeBlock:          "locator_block.tmpl",
+	LocatorTypeLine:           "locator_line.tmpl",
 }
 
 type LocationOutput struct {
@@ -91,7 +143,10 @@ func (l Locator) Locate(ctx context.Context, locatorType LocatorType, query stri
 	}
 
 	// Locate relevant files
-	templatefile := locatorTypeMap[LocatorTypeFile]
+	templatefile, err := l.prompts.Template(locatorTypeMap[LocatorTypeFile])
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template: %v", err)
+	}
 	filelist, err := l.locateFile(ctx, templatefile, query, repoStructure)
 	if err != nil {
 		return nil, fmt.Errorf("failed to locate file: %v", err)
@@ -99,14 +154,20 @@ func (l Locator) Locate(ctx context.Context, locatorType LocatorType, query stri
 	fmt.Println(filelist)
 
 	// Locate block or line
-	templatefile = locatorTypeMap[LocatorTypeBlock]
+	templatefile, err = l.prompts.Template(locatorTypeMap[LocatorTypeBlock])
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template: %v", err)
+	}
 	blocklist, err := l.locateBlock(ctx, templatefile, query, filelist)
 	if err != nil {
 		return nil, fmt.Errorf("failed to locate block: %v", err)
 	}
 
 	// Locate line
-	templatefile = locatorTypeMap[LocatorTypeLine]
+	templatefile, err = l.prompts.Template(locatorTypeMap[LocatorTypeLine])
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template: %v", err)
+	}
 	_, err = l.locateLine(ctx, templatefile, query, filelist, blocklist)
 	if err != nil {
 		return nil, fmt.Errorf("failed to locate line: %v", err)
@@ -143,6 +204,14 @@ func (l Locator) locateFile(ctx context.Context, templatefile, query string, rep
 		verifieldFileList.Paths = append(verifieldFileList.Paths, path)
 	}
 
+	if l.searcher != nil {
+		docs, err := l.searcher.Search(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search: %v", err)
+		}
+		verifieldFileList.Paths = mergePaths(verifieldFileList.Paths, docs)
+	}
+
 	return &verifieldFileList, nil
 }
 
@@ -162,7 +231,7 @@ func (l Locator) locateBlock(ctx context.Context, templatefile, query string, fi
 		fileContents[path] = content
 	}
 
-	fileContentsStr, err := formatFileContents(fileContents)
+	fileContentsStr, err := l.formatFileContents(fileContents)
 	if err != nil {
 		return nil, fmt.Errorf("failed to format file contents: %v", err)
 	}
@@ -206,7 +275,7 @@ func (l Locator) locateLine(ctx context.Context, templatefile, query string, fil
 		fileContents[path] = content
 	}
 
-	fileContentsStr, err := formatFileContents(fileContents)
+	fileContentsStr, err := l.formatFileContents(fileContents)
 	if err != nil {
 		return nil, fmt.Errorf("failed to format file contents: %v", err)
 	}
@@ -231,8 +300,28 @@ func (l Locator) locateLine(ctx context.Context, templatefile, query string, fil
 	return &fileBlockLineList, nil
 }
 
-func formatFileContents(fileContents map[string]string) (string, error) {
-	tmpl, err := template.New("template").Parse(fileContentTemplate)
+// mergePaths appends the paths of the documents that are not in paths.
+func mergePaths(paths []string, docs []vectorstore.DocumentWithScore) []string {
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		seen[path] = true
+	}
+	for _, doc := range docs {
+		if seen[doc.Document.Filepath] {
+			continue
+		}
+		seen[doc.Document.Filepath] = true
+		paths = append(paths, doc.Document.Filepath)
+	}
+	return paths
+}
+
+func (l Locator) formatFileContents(fileContents map[string]string) (string, error) {
+	templatefile, err := l.prompts.Template("file_content.tmpl")
+	if err != nil {
+		return "", fmt.Errorf("failed to load file template: %v", err)
+	}
+	tmpl, err := template.New("template").Parse(templatefile)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse file template: %v", err)
 	}
@@ -248,10 +337,7 @@ func formatFileContents(fileContents map[string]string) (string, error) {
 func makeLocateFilePrompt(templatefile, query string, repoStructure loader.RepoStructure) (string, error) {
 
 	var prompt string
-	tmplData := struct {
-		Query         string
-		RepoStructure string
-	}{
+	tmplData := locateFileData{
 		Query:         query,
 		RepoStructure: repoStructure.ToTreeString(),
 	}
@@ -273,10 +359,7 @@ func makeLocateFilePrompt(templatefile, query string, repoStructure loader.RepoS
 func makeLocateBlockPrompt(templatefile, query, fileContents string) (string, error) {
 
 	var prompt string
-	tmplData := struct {
-		Query        string
-		FileContents string
-	}{
+	tmplData := locateContentsData{
 		Query:        query,
 		FileContents: fileContents,
 	}
@@ -298,10 +381,7 @@ func makeLocateBlockPrompt(templatefile, query, fileContents string) (string, er
 func makeLocateLinePrompt(templatefile, query, fileContents string) (string, error) {
 
 	var prompt string
-	tmplData := struct {
-		Query        string
-		FileContents string
-	}{
+	tmplData := locateContentsData{
 		Query:        query,
 		FileContents: fileContents,
 	}
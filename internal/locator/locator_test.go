@@ -1,9 +1,11 @@
 package locator
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/nakamasato/aicoder/internal/loader"
+	"github.com/nakamasato/aicoder/internal/vectorstore"
 )
 
 func TestMakePrompt(t *testing.T) {
@@ -48,3 +50,15 @@ func TestMakePrompt(t *testing.T) {
 		})
 	}
 }
+
+func TestMergePaths(t *testing.T) {
+	docs := []vectorstore.DocumentWithScore{
+		{Document: &vectorstore.Document{Filepath: "cmd/review/cmd.go"}},
+		{Document: &vectorstore.Document{Filepath: "internal/reviewer/reviewer.go"}},
+	}
+	got := mergePaths([]string{"internal/reviewer/reviewer.go"}, docs)
+	want := []string{"internal/reviewer/reviewer.go", "cmd/review/cmd.go"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergePaths() = %v, want %v", got, want)
+	}
+}
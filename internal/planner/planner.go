@@ -3,10 +3,11 @@ package planner
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
@@ -14,19 +15,90 @@ import (
 	"github.com/nakamasato/aicoder/ent"
 	"github.com/nakamasato/aicoder/internal/file"
 	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/prompt"
 	"github.com/nakamasato/aicoder/internal/summarizer"
+	"github.com/nakamasato/aicoder/internal/symbol"
+	"github.com/nakamasato/aicoder/internal/tool"
 )
 
 type Planner struct {
-	llmClient llm.Client
-	entClient *ent.Client
+	llmClient   llm.Client
+	entClient   *ent.Client
+	concurrency int
+	prompts     *prompt.Loader
+	conventions string
+	tools       []tool.Tool
+	toolRounds  int
+	// referenceIndex is the index of the symbols in the repository to find the blocks affected by the changes
+	referenceIndex *symbol.Index
+	typeChecker    TypeChecker
+	maxRepairs     int
 }
 
-func NewPlanner(llmClient llm.Client, entClient *ent.Client) *Planner {
-	return &Planner{
-		llmClient: llmClient,
-		entClient: entClient,
+type PlannerOption func(*Planner)
+
+// WithConcurrency sets the maximum number of block changes generated concurrently (default: 5).
+func WithConcurrency(n int) PlannerOption {
+	return func(p *Planner) {
+		if n > 0 {
+			p.concurrency = n
+		}
+	}
+}
+
+// WithPrompts loads the prompt templates and the examples overridden in the prompts directory (default: the built-in ones).
+func WithPrompts(prompts *prompt.Loader) PlannerOption {
+	return func(p *Planner) {
+		p.prompts = prompts
+	}
+}
+
+// WithConventions sets the coding conventions of the repository (e.g. the content of AICODER.md) that the changes must follow.
+func WithConventions(conventions string) PlannerOption {
+	return func(p *Planner) {
+		p.conventions = conventions
+	}
+}
+
+// WithTools makes the investigation steps call the tools (e.g. grep, read_file) to collect the information
+// that is not in the retrieved files. maxRounds is the maximum number of rounds of tool calls per step (default: 5).
+func WithTools(maxRounds int, tools ...tool.Tool) PlannerOption {
+	return func(p *Planner) {
+		p.tools = tools
+		if maxRounds > 0 {
+			p.toolRounds = maxRounds
+		}
+	}
+}
+
+// WithConsistencyCheck adds the follow-up changes to the callers and the implementations affected by
+// the changes of the Go declarations (e.g. a function signature or an interface) found with the index.
+func WithConsistencyCheck(index *symbol.Index) PlannerOption {
+	return func(p *Planner) {
+		p.referenceIndex = index
+	}
+}
+
+// WithTypeCheck type-checks the Go changes applied to the current files in memory after generating them and
+// regenerates the changes with the compile errors up to maxRepairs times. 0 only reports the compile errors.
+func WithTypeCheck(checker TypeChecker, maxRepairs int) PlannerOption {
+	return func(p *Planner) {
+		p.typeChecker = checker
+		p.maxRepairs = max(maxRepairs, 0)
+	}
+}
+
+func NewPlanner(llmClient llm.Client, entClient *ent.Client, opts ...PlannerOption) *Planner {
+	p := &Planner{
+		llmClient:   llmClient,
+		entClient:   entClient,
+		concurrency: 5,
+		toolRounds:  5,
+	}
+	for _, opt := range opts {
+		opt(p)
 	}
+	return p
 }
 
 // ChangesPlan is a list of changes each of which consists of BlockChange.
@@ -34,9 +106,11 @@ func NewPlanner(llmClient llm.Client, entClient *ent.Client) *Planner {
 // Block is different in each language. For example, in Go, a block is a function. In HCL, a block is a resource.
 // This will replace ChangeFilePlan.
 type ChangesPlan struct {
-	Id      string        `json:"id" jsonschema_description:"ID of the plan"`
-	Query   string        `json:"query" jsonschema_description:"The goal of the changes"`
-	Changes []BlockChange `json:"changes" jsonschema_description:"List of changes to be made to meet the requirements"`
+	Id             string          `json:"id" jsonschema_description:"ID of the plan"`
+	Query          string          `json:"query" jsonschema_description:"The goal of the changes"`
+	ActionPlan     *ActionPlan     `json:"action_plan,omitempty" jsonschema_description:"The steps to investigate and change the files to achieve the goal"`
+	Investigations []Investigation `json:"investigations,omitempty" jsonschema_description:"The results of the investigation steps"`
+	Changes        []BlockChange   `json:"changes" jsonschema_description:"List of changes to be made to meet the requirements"`
 }
 
 type ActionType string
@@ -70,15 +144,49 @@ func (c ChangesPlan) String() string {
 }
 
 // BlockChange is a change to a block of code.
+// For ActionTypeAdd, NewContent is the entire new block (e.g. a function declaration with its comment) or the entire new file.
+// For ActionTypeDelete, NewContent and NewComment are ignored.
+// Block.Content and FileHash record the block and the file at plan time to detect the changes made to the file after planning.
 type BlockChange struct {
-	Block      Block  `json:"block" jsonschema_description:"The target block to be changed"`
-	NewContent string `json:"new_content" jsonschema_description:"The new content of the block. Leave it empty to keep the current content and just update comment."`
-	NewComment string `json:"new_comment" jsonschema_description:"The new comment of the block that is written above the block. Leave it empty to keep the current comment and just update content. HCL file does not support updating comment yet."`
+	Action     ActionType `json:"action,omitempty" jsonschema_description:"Action of the change: add, update or delete. Empty means update."`
+	Block      Block      `json:"block" jsonschema_description:"The target block to be changed"`
+	NewContent string     `json:"new_content" jsonschema_description:"The new content of the block. Leave it empty to keep the current content and just update comment."`
+	NewComment string     `json:"new_comment" jsonschema_description:"The new comment of the block that is written above the block. Leave it empty to keep the current comment and just update content. HCL file does not support updating comment yet."`
+	FileHash   string     `json:"file_hash,omitempty" jsonschema_description:"SHA-256 hash of the content of the file at plan time. Empty for a new file."`
+	Decision   Decision   `json:"decision,omitempty" jsonschema_description:"The decision made on the change with apply --interactive: accepted or rejected. Empty means undecided."`
+}
+
+// Decision is the decision made on a BlockChange when reviewing the plan interactively.
+type Decision string
+
+const (
+	DecisionAccepted Decision = "accepted"
+	DecisionRejected Decision = "rejected"
+)
+
+// GetAction returns the action of the change. Changes without action (e.g. plans generated by older versions) are updates.
+func (c BlockChange) GetAction() ActionType {
+	if c.Action == "" {
+		return ActionTypeUpdate
+	}
+	return c.Action
 }
 
 // TargetBlocks is a list of candidate blocks to be modified to achieve the goal.
 type TargetBlocks struct {
-	Changes []Block `json:"changes" jsonschema_description:"List of candidate blocks to be modified to achieve the goal"`
+	Changes []TargetBlock `json:"changes" jsonschema_description:"List of candidate blocks to be added, updated or deleted to achieve the goal"`
+}
+
+// TargetBlock is a block to be changed with the action.
+type TargetBlock struct {
+	Action     ActionType `json:"action" jsonschema:"enum=add,enum=update,enum=delete" jsonschema_description:"add: add a new block (or a new file when target_type is file), update: update an existing block, delete: delete an existing block (or the file when target_type is file)"`
+	Path       string     `json:"path" jsonschema_description:"Path to the file to be changed"`
+	TargetType string     `json:"target_type" jsonschema_description:"Type of the target block. e.g. file, function, method, struct, interface, type, const, var, import (Go), resource, variable, module (HCL), etc"`
+	TargetName string     `json:"target_name" jsonschema_description:"Name of the target block. Please set file path when TargetType is 'file'. Methods are qualified with the receiver type. e.g. Command, runPlan, Client, Client.Search"`
+}
+
+func (t TargetBlock) Block() Block {
+	return Block{Path: t.Path, TargetType: t.TargetType, TargetName: t.TargetName}
 }
 
 // Block represents a block of code to be changed.
@@ -176,14 +284,65 @@ func (p *Planner) removeUnrelevantFiles(ctx context.Context, query string, files
 	return filteredFiles, nil
 }
 
-// GenerateBlockChangePlan generates a plan to change a block of code.
-// Use an appropriate prompt template for each language.
-func (p *Planner) GenerateBlockChangePlan(ctx context.Context, promptTemplate string, block Block, blockContent string, investigationResult string, currentPlan *ChangesPlan, review string) (*BlockChange, error) {
+// keepTestCompanions adds back the Go test files that were removed by the relevance filter
+// when the Go file they test is kept so that tests can be changed together with the code.
+func keepTestCompanions(files, filteredFiles []file.File) []file.File {
+	kept := make(map[string]bool)
+	keptDirs := make(map[string]bool)
+	for _, f := range filteredFiles {
+		kept[f.Path] = true
+		if filepath.Ext(f.Path) == ".go" && !file.IsGoTestFile(f.Path) {
+			keptDirs[filepath.Dir(f.Path)] = true
+		}
+	}
+	for _, f := range files {
+		if kept[f.Path] || !file.IsGoTestFile(f.Path) || !keptDirs[filepath.Dir(f.Path)] {
+			continue
+		}
+		fmt.Printf("%d. test file of relevant file: %s\n", len(filteredFiles), f.Path)
+		filteredFiles = append(filteredFiles, f)
+		kept[f.Path] = true
+	}
+	return filteredFiles
+}
+
+// parseCandidateBlocks parses the files into the candidate blocks with the registered languages.
+// The entire file is the only block of the files without a registered language.
+func parseCandidateBlocks(files []file.File) map[string][]Block {
+	candidateBlocks := map[string][]Block{}
+	for i, f := range files {
+		fmt.Printf("File %d: %s\n", i+1, f.Path)
+		lang, ok := LanguageFor(f.Path)
+		if !ok { // file: block is the entire file
+			candidateBlocks[f.Path] = append(candidateBlocks[f.Path], Block{Path: f.Path, TargetType: "file", TargetName: f.Path, Content: f.Content})
+			continue
+		}
+		blocks, err := lang.ParseBlocks(f.Path)
+		if err != nil {
+			fmt.Printf("failed to parse %s file: %v\n", lang.Name(), err)
+			continue
+		}
+		for _, b := range blocks {
+			fmt.Printf("Block: Type:%s, Name:%s\n", b.TargetType, b.TargetName)
+		}
+		candidateBlocks[f.Path] = append(candidateBlocks[f.Path], blocks...)
+	}
+	return candidateBlocks
+}
+
+// GenerateBlockChangePlan generates a plan to change a block of code for the step.
+// Use an appropriate prompt template for each language and action.
+// For ActionTypeAdd, blockContent is the current content of the file to add the block to (empty for a new file).
+func (p *Planner) GenerateBlockChangePlan(ctx context.Context, promptTemplate string, action ActionType, step string, block Block, blockContent string, investigationResult string, currentPlan *ChangesPlan, review string) (*BlockChange, error) {
 	messages := []llm.Message{
 		{Role: llm.RoleSystem, Content: "You're an experienced software engineer who is tasked to refactor/update the existing code."},
 		{Role: llm.RoleSystem, Content: fmt.Sprintf("You can also utilize the investigation results: %s", investigationResult)},
-		{Role: llm.RoleUser, Content: fmt.Sprintf(promptTemplate, block.TargetName, block.Path, blockContent)},
+		{Role: llm.RoleSystem, Content: fmt.Sprintf("The change is a part of the step: %s", step)},
+	}
+	if p.conventions != "" {
+		messages = append(messages, p.conventionsMessage())
 	}
+	messages = append(messages, llm.Message{Role: llm.RoleUser, Content: fmt.Sprintf(promptTemplate, block.TargetName, block.Path, blockContent)})
 	if currentPlan != nil && review != "" {
 		messages = append(messages, llm.Message{Role: llm.RoleSystem, Content: fmt.Sprintf("The followings are current plan and review. Please improve the existing plan based on the review:\nCurrent Plan: %s\nReview:%s", currentPlan.String(), review)})
 
@@ -202,6 +361,7 @@ func (p *Planner) GenerateBlockChangePlan(ctx context.Context, promptTemplate st
 	}
 
 	plan := &BlockChange{
+		Action:     action,
 		Block:      block,
 		NewContent: change.NewContent,
 		NewComment: change.NewComment,
@@ -220,36 +380,11 @@ func (p *Planner) GeneratePlan(ctx context.Context, query string, summary *summa
 	if err != nil {
 		return nil, fmt.Errorf("failed to remove irrelevant files: %w", err)
 	}
+	filteredFiles = keepTestCompanions(files, filteredFiles)
 
 	// 1. Identify candidate blocks to change
 	fmt.Printf("---------- 1. Identify candidate blocks to change -----------\n")
-	candidateBlocks := map[string][]Block{}
-	for i, f := range filteredFiles {
-		fmt.Printf("File %d: %s\n", i+1, f.Path)
-		if filepath.Ext(f.Path) == ".go" {
-			functions, _, err := file.ParseGo(f.Path)
-			if err != nil {
-				fmt.Printf("failed to parse go file: %v", err)
-				continue
-			}
-			for _, fn := range functions {
-				fmt.Printf("Function: %s\n", fn.Name)
-				candidateBlocks[f.Path] = append(candidateBlocks[f.Path], Block{Path: f.Path, TargetType: "function", TargetName: fn.Name, Content: fn.Content})
-			}
-		} else if filepath.Ext(f.Path) == ".hcl" || filepath.Ext(f.Path) == ".tf" {
-			blocks, _, err := file.ParseHCL(f.Path)
-			if err != nil {
-				fmt.Printf("failed to parse hcl file: %v", err)
-				continue
-			}
-			for _, b := range blocks {
-				fmt.Printf("Block: Type:%s, Labels:%s\n", b.Type, strings.Join(b.Labels, ","))
-				candidateBlocks[f.Path] = append(candidateBlocks[f.Path], Block{Path: f.Path, TargetType: b.Type, TargetName: strings.Join(b.Labels, ","), Content: b.Content})
-			}
-		} else { // file: block is the entire file
-			candidateBlocks[f.Path] = append(candidateBlocks[f.Path], Block{Path: f.Path, TargetType: "file", TargetName: f.Path, Content: f.Content})
-		}
-	}
+	candidateBlocks := parseCandidateBlocks(filteredFiles)
 
 	// 2. Make action plan (steps)
 	fmt.Printf("---------- 2. Make action plan (steps) -----------\n")
@@ -268,21 +403,26 @@ func (p *Planner) GeneratePlan(ctx context.Context, query string, summary *summa
 
 	// 3. Investigation Step
 	fmt.Printf("---------- 3. Investigation step -----------\n")
-	investigationResultStr, err := p.executeInvestigation(ctx, query, plan.InvestigateSteps, files)
+	investigations, err := p.executeInvestigation(ctx, query, plan.InvestigateSteps, files)
 	if err != nil {
 		return nil, fmt.Errorf("failed to investigate: %w", err)
 	}
+	investigationResultStr := investigationsString(investigations)
+	filteredFiles = addTargetFiles(investigations, filteredFiles, candidateBlocks)
 
 	// 4. Change file step
 	fmt.Printf("---------- 4. Change file step -----------\n")
 	changesPlan := &ChangesPlan{
-		Id:      uuid.New().String(),
-		Query:   query,
-		Changes: []BlockChange{},
+		Id:             uuid.New().String(),
+		Query:          query,
+		ActionPlan:     plan,
+		Investigations: investigations,
+		Changes:        []BlockChange{},
 	}
 	if currentPlan != nil && currentPlan.Id != "" {
 		changesPlan.Id = currentPlan.Id
 	}
+	stepTargets := make([][]TargetBlock, len(plan.ChangeSteps))
 	for i, step := range plan.ChangeSteps {
 		fmt.Printf("Change Step %d: %s\n", i+1, step)
 
@@ -292,56 +432,210 @@ func (p *Planner) GeneratePlan(ctx context.Context, query string, summary *summa
 			return nil, fmt.Errorf("failed to identify blocks to change: %w", err)
 		}
 		fmt.Printf("Step %d: Got %d blocks\n", i+1, len(blocks.Changes))
+		for _, target := range blocks.Changes {
+			fmt.Printf("Step %d: Block action:%s path:%s type:%s name:%s\n", i+1, target.Action, target.Path, target.TargetType, target.TargetName)
+		}
+		stepTargets[i] = blocks.Changes
+	}
 
-		for _, blkToChange := range blocks.Changes {
-			// TODO: create Planner interface and implement planner for each Language
-			if filepath.Ext(blkToChange.Path) == ".go" {
-				// Use function as a unit of block for go
-				blocks := candidateBlocks[blkToChange.Path]
-				for _, blk := range blocks {
-					if blk.TargetName == blkToChange.TargetName && blk.TargetType == blkToChange.TargetType {
-						fmt.Printf("Step %d: Matched Block Go path:%s, type:%s, name:%s\n", i+1, blk.Path, blk.TargetType, blk.TargetName)
-						blkChange, err := p.GenerateBlockChangePlan(ctx, GENERATE_FUNCTION_CHANGES_PLAN_PROMPT_GO, blkToChange, blk.Content, investigationResultStr, changesPlan, review)
-						if err != nil {
-							log.Fatalf("failed to generate plan: %v", err)
-							return nil, fmt.Errorf("failed to generate plan: %w", err)
-						}
-						changesPlan.Changes = append(changesPlan.Changes, *blkChange)
-						break
-					}
-				}
-			} else if filepath.Ext(blkToChange.Path) == ".hcl" || filepath.Ext(blkToChange.Path) == ".tf" {
-				// Use block as a unit of block for hcl
-				blocks := candidateBlocks[blkToChange.Path]
-				for _, blk := range blocks {
-					if blk.TargetName == blkToChange.TargetName && blk.TargetType == blkToChange.TargetType { // variable, resource, module, etc.
-						fmt.Printf("Step %d: Matched Block HCL path:%s, type:%s, name:%s\n", i+1, blk.Path, blk.TargetType, blk.TargetName)
-						blkChange, err := p.GenerateBlockChangePlan(ctx, GENERATE_BLOCK_CHANGES_PLAN_PROMPT_HCL, blkToChange, blk.Content, investigationResultStr, changesPlan, review)
-						if err != nil {
-							log.Fatalf("failed to generate plan: %v", err)
-							return nil, fmt.Errorf("failed to generate plan: %w", err)
-						}
-						changesPlan.Changes = append(changesPlan.Changes, *blkChange)
-						break
-					}
-				}
-				// TODO: enable to change attr in hcl
-			} else if blkToChange.TargetType == "file" {
-				for _, blk := range candidateBlocks[blkToChange.Path] {
-					blkChange, err := p.GenerateBlockChangePlan(ctx, GENERATE_BLOCK_CHANGES_PROMPT_ENTIRE_FILE, blkToChange, blk.Content, investigationResultStr, changesPlan, review)
-					if err != nil {
-						log.Fatalf("failed to generate plan: %v", err)
-					}
-					changesPlan.Changes = append(changesPlan.Changes, *blkChange)
-				}
+	// generate the changes of the blocks concurrently. The steps targeting the same block are merged into one change.
+	changes, err := p.generateBlockChanges(ctx, mergeStepTargets(plan.ChangeSteps, stepTargets), filteredFiles, candidateBlocks, investigationResultStr, currentPlan, review)
+	if err != nil {
+		return nil, err
+	}
+	changesPlan.Changes = append(changesPlan.Changes, changes...)
+
+	// 5. Consistency check
+	if p.referenceIndex != nil {
+		fmt.Printf("---------- 5. Consistency check -----------\n")
+		if err := p.addFollowUpChanges(ctx, changesPlan, investigationResultStr); err != nil {
+			return nil, err
+		}
+	}
+
+	// 6. Type check
+	if p.typeChecker != nil {
+		fmt.Printf("---------- 6. Type check -----------\n")
+		if err := p.repairChanges(ctx, changesPlan); err != nil {
+			return nil, err
+		}
+	}
+
+	return changesPlan, nil
+}
+
+// stepTarget is a block to be changed with all the steps targeting it.
+type stepTarget struct {
+	target TargetBlock
+	steps  []string
+}
+
+// mergeStepTargets merges the targets of the steps by block so that each block is changed once for all the steps targeting it.
+// The targets are ordered by their first appearance in the steps.
+func mergeStepTargets(steps []string, stepTargets [][]TargetBlock) []*stepTarget {
+	var targets []*stepTarget
+	seen := map[string]*stepTarget{}
+	for i, ts := range stepTargets {
+		for _, t := range ts {
+			key := strings.Join([]string{t.Path, t.TargetType, t.TargetName}, "\x00")
+			if t.TargetType == "file" {
+				key = t.Path
+			}
+			st, ok := seen[key]
+			if !ok {
+				st = &stepTarget{target: t}
+				seen[key] = st
+				targets = append(targets, st)
 			} else {
-				fmt.Printf("Step %d: Unsupported file type: %s\n", i+1, blkToChange.Path)
+				action, ok := mergeAction(st.target.Action, t.Action)
+				if !ok {
+					fmt.Printf("Step %d: Drop block path:%s type:%s name:%s added by the previous steps\n", i+1, t.Path, t.TargetType, t.TargetName)
+					delete(seen, key)
+					targets = slices.DeleteFunc(targets, func(target *stepTarget) bool { return target == st })
+					continue
+				}
+				fmt.Printf("Step %d: Merge block path:%s type:%s name:%s into the change of the previous steps\n", i+1, t.Path, t.TargetType, t.TargetName)
+				st.target.Action = action
+			}
+			if len(st.steps) == 0 || st.steps[len(st.steps)-1] != steps[i] {
+				st.steps = append(st.steps, steps[i])
 			}
-			fmt.Printf("Step %d: Block path:%s type:%s name:%s\n", i+1, blkToChange.Path, blkToChange.TargetType, blkToChange.TargetName)
 		}
 	}
+	return targets
+}
 
-	return changesPlan, nil
+// mergeAction returns the action of the block targeted by two steps in order.
+// The later action wins except that a block added earlier is still added and a block deleted and added again is updated.
+// It returns false if the block is added and deleted again so that the block is not changed at all.
+func mergeAction(current, next ActionType) (ActionType, bool) {
+	switch {
+	case current == ActionTypeAdd && next == ActionTypeUpdate:
+		return ActionTypeAdd, true
+	case current == ActionTypeAdd && next == ActionTypeDelete:
+		return "", false
+	case current == ActionTypeDelete && next == ActionTypeAdd:
+		return ActionTypeUpdate, true
+	default:
+		return next, true
+	}
+}
+
+// generateBlockChanges generates the changes of the targets concurrently up to the concurrency of the planner.
+// The changes are returned in the order of the targets. The targets that cannot be changed are skipped.
+func (p *Planner) generateBlockChanges(ctx context.Context, targets []*stepTarget, files []file.File, candidateBlocks map[string][]Block, investigationResult string, currentPlan *ChangesPlan, review string) ([]BlockChange, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*BlockChange, len(targets))
+	errs := make([]error, len(targets))
+	sem := make(chan struct{}, p.concurrency)
+	var wg sync.WaitGroup
+	for i, t := range targets {
+		wg.Add(1)
+		go func(i int, t *stepTarget) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i], errs[i] = p.generateBlockChange(ctx, strings.Join(t.steps, "\n"), t.target, files, candidateBlocks, investigationResult, currentPlan, review)
+			if errs[i] != nil {
+				cancel() // stop generating the other changes
+			}
+		}(i, t)
+	}
+	wg.Wait()
+
+	// report the first error that is not caused by the cancellation
+	var firstErr error
+	for i, t := range targets {
+		if errs[i] == nil || (firstErr != nil && errors.Is(errs[i], context.Canceled)) {
+			continue
+		}
+		err := fmt.Errorf("failed to generate change of block path:%s type:%s name:%s: %w", t.target.Path, t.target.TargetType, t.target.TargetName, errs[i])
+		if firstErr == nil || errors.Is(firstErr, context.Canceled) {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	var changes []BlockChange
+	for i, t := range targets {
+		if results[i] == nil {
+			fmt.Printf("Skip block path:%s type:%s name:%s\n", t.target.Path, t.target.TargetType, t.target.TargetName)
+			continue
+		}
+		// record the file at plan time to detect the changes made after planning
+		hash, err := file.HashFile(results[i].Block.Path)
+		if err != nil {
+			return nil, err
+		}
+		results[i].FileHash = hash
+		changes = append(changes, *results[i])
+	}
+	return changes, nil
+}
+
+// generateBlockChange generates the change of the target block for the step.
+// The prompt template is provided by the language of the file (see Language).
+// nil is returned if the target cannot be changed (e.g. the block to update is not found or the file type is not supported).
+func (p *Planner) generateBlockChange(ctx context.Context, step string, target TargetBlock, files []file.File, candidateBlocks map[string][]Block, investigationResult string, currentPlan *ChangesPlan, review string) (*BlockChange, error) {
+	switch target.Action {
+	case ActionTypeAdd:
+		if target.TargetType == "file" {
+			return p.GenerateBlockChangePlan(ctx, GENERATE_NEW_FILE_PROMPT, ActionTypeAdd, step, target.Block(), "", investigationResult, currentPlan, review)
+		}
+		lang, ok := LanguageFor(target.Path)
+		if !ok {
+			return nil, nil
+		}
+		promptTemplate := lang.PromptTemplate(ActionTypeAdd, target.TargetType)
+		if promptTemplate == "" {
+			return nil, nil
+		}
+		var fileContent string
+		for _, f := range files {
+			if f.Path == target.Path {
+				fileContent = f.Content
+			}
+		}
+		return p.GenerateBlockChangePlan(ctx, promptTemplate, ActionTypeAdd, step, target.Block(), fileContent, investigationResult, currentPlan, review)
+	case ActionTypeDelete:
+		// no content is necessary to delete a block
+		blk, ok := findBlock(candidateBlocks[target.Path], target)
+		if !ok {
+			return nil, nil
+		}
+		return &BlockChange{Action: ActionTypeDelete, Block: blk}, nil
+	default:
+		blk, ok := findBlock(candidateBlocks[target.Path], target)
+		if !ok {
+			return nil, nil
+		}
+		promptTemplate := GENERATE_BLOCK_CHANGES_PROMPT_ENTIRE_FILE
+		if lang, ok := LanguageFor(target.Path); ok && target.TargetType != "file" {
+			promptTemplate = lang.PromptTemplate(ActionTypeUpdate, target.TargetType)
+			if promptTemplate == "" {
+				return nil, nil
+			}
+		}
+		return p.GenerateBlockChangePlan(ctx, promptTemplate, ActionTypeUpdate, step, blk, blk.Content, investigationResult, currentPlan, review)
+	}
+}
+
+// findBlock finds the candidate block with the same type and name as the target.
+// The entire file is matched by its path when the target type is file.
+func findBlock(blocks []Block, target TargetBlock) (Block, bool) {
+	for _, blk := range blocks {
+		if blk.TargetType == target.TargetType && blk.TargetName == target.TargetName {
+			return blk, true
+		}
+		if target.TargetType == "file" && blk.TargetType == "file" {
+			return blk, true
+		}
+	}
+	return Block{}, false
 }
 
 func (p *Planner) identifyBlocksToChangeForStep(ctx context.Context, step string, files []file.File, candidateBlocks map[string][]Block) (*TargetBlocks, error) {
@@ -370,12 +664,19 @@ func (p *Planner) identifyBlocksToChangeForStep(ctx context.Context, step string
 // 2. Make action plan (steps)
 func (p *Planner) makeActionPlan(ctx context.Context, currentPlan *ChangesPlan, dirStructure, query, review string) (*ActionPlan, error) {
 	// Use LLM to generate action plan
-	examples_str := convertActionPlanExaplesToStr(DefaultActionPlanExamples)
+	examples, err := prompt.Examples[[]ActionPlanExample](p.prompts, "action_plan.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load action plan examples: %w", err)
+	}
+	examples_str := convertActionPlanExaplesToStr(examples)
 	prompt := fmt.Sprintf(GENERATE_ACTION_PLAN_PROMPT, dirStructure, query, examples_str)
 	messages := []llm.Message{
 		{Role: llm.RoleSystem, Content: "You're an experienced software engineer who is tasked to refactor/update the existing code."},
-		{Role: llm.RoleUser, Content: prompt},
 	}
+	if p.conventions != "" {
+		messages = append(messages, p.conventionsMessage())
+	}
+	messages = append(messages, llm.Message{Role: llm.RoleUser, Content: prompt})
 	if currentPlan != nil && review != "" {
 		messages = append(messages, llm.Message{Role: llm.RoleSystem, Content: fmt.Sprintf("The followings are current plan and review. Please improve the existing plan based on the review:\nCurrent Plan: %s\nReview:%s", currentPlan.String(), review)})
 	}
@@ -393,6 +694,11 @@ func (p *Planner) makeActionPlan(ctx context.Context, currentPlan *ChangesPlan,
 	return &plan, nil
 }
 
+// conventionsMessage returns the system message to make the plan follow the conventions of the repository.
+func (p *Planner) conventionsMessage() llm.Message {
+	return llm.Message{Role: llm.RoleSystem, Content: fmt.Sprintf("The changes must follow the conventions of the repository below. Do not plan or write anything that violates them:\n%s", p.conventions)}
+}
+
 func LoadPlanFile[T any](planFile string) (*T, error) {
 	data, err := os.ReadFile(planFile)
 	if err != nil {
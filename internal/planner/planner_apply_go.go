@@ -0,0 +1,221 @@
+package planner
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nakamasato/aicoder/internal/file"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// Apply applies the change to the Go source read from r.
+func (goLanguage) Apply(r io.Reader, c BlockChange) ([]byte, error) {
+
+	switch c.Block.TargetType {
+	case file.GoBlockFunction, file.GoBlockMethod, file.GoBlockStruct, file.GoBlockInterface, file.GoBlockType, file.GoBlockConst, file.GoBlockVar, file.GoBlockImport:
+	default:
+		return nil, fmt.Errorf("unsupported target type: %s", c.Block.TargetType)
+	}
+
+	switch c.GetAction() {
+	case ActionTypeAdd:
+		return addDeclGo(r, c.NewContent)
+	case ActionTypeDelete:
+		return deleteDeclGo(r, c.Block.TargetType, c.Block.TargetName)
+	default:
+		if c.Block.TargetType == file.GoBlockFunction || c.Block.TargetType == file.GoBlockMethod {
+			return updateFuncGo(r, c.Block.TargetType, c.Block.TargetName, c.NewContent, c.NewComment)
+		}
+		return replaceDeclGo(r, c.Block.TargetType, c.Block.TargetName, c.NewContent, c.NewComment)
+	}
+}
+
+// addDeclGo appends the new declarations (e.g. a function with its comment) to the end of the Go file.
+// The imports of the new declarations are merged into the imports of the file.
+func addDeclGo(reader io.Reader, content string) ([]byte, error) {
+	source, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from reader: %w", err)
+	}
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("no content to add")
+	}
+
+	// Make sure the new content only has declarations
+	src := "package p\n" + content
+	fs := token.NewFileSet()
+	decls, err := parser.ParseFile(fs, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new declarations: %w", err)
+	}
+
+	// the import declarations come before the other declarations
+	body := content
+	for _, decl := range decls.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			body = src[fs.Position(gen.End()).Offset:]
+		}
+	}
+
+	updated := append(bytes.TrimRight(source, "\n"), []byte("\n\n"+strings.TrimSpace(body)+"\n")...)
+	if len(decls.Imports) > 0 {
+		if updated, err = addImports(updated, decls.Imports); err != nil {
+			return nil, err
+		}
+	}
+	formatted, err := format.Source(updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to format updated Go code: %w", err)
+	}
+	return formatted, nil
+}
+
+// addImports adds the imports to the Go source unless they are already imported.
+func addImports(source []byte, imports []*ast.ImportSpec) ([]byte, error) {
+	fs := token.NewFileSet()
+	node, err := parser.ParseFile(fs, "", source, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Go source: %w", err)
+	}
+	for _, imp := range imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid import path %s: %w", imp.Path.Value, err)
+		}
+		var name string
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		astutil.AddNamedImport(fs, node, name, path)
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fs, node); err != nil {
+		return nil, fmt.Errorf("failed to generate updated Go code: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// deleteDeclGo deletes the block and its comment from the Go file.
+func deleteDeclGo(reader io.Reader, blockType, name string) ([]byte, error) {
+	source, blk, err := findGoBlock(reader, blockType, name)
+	if err != nil {
+		return nil, err
+	}
+	return formatGo(source[:blk.DocStart], source[blk.End:])
+}
+
+// replaceDeclGo replaces the entire declaration of the type, const/var group or import with the new content.
+// The comment is replaced if specified. Set content or comment to an empty string to keep the existing one.
+func replaceDeclGo(reader io.Reader, blockType, name, content, comment string) ([]byte, error) {
+	source, blk, err := findGoBlock(reader, blockType, name)
+	if err != nil {
+		return nil, err
+	}
+
+	newContent := blk.Content
+	if content != "" {
+		newContent = strings.TrimSpace(content)
+		if blk.Grouped {
+			// the type spec in a grouped declaration doesn't have the keyword
+			newContent = strings.TrimPrefix(newContent, "type ")
+		}
+	}
+
+	start := blk.Start
+	if comment != "" {
+		start = blk.DocStart
+		newContent = formatComment(comment) + "\n" + newContent
+	}
+	return formatGo(source[:start], []byte(newContent), source[blk.End:])
+}
+
+// findGoBlock reads the Go source and finds the block with the type and the name.
+func findGoBlock(reader io.Reader, blockType, name string) ([]byte, file.GoBlock, error) {
+	source, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, file.GoBlock{}, fmt.Errorf("failed to read from reader: %w", err)
+	}
+	blocks, err := file.ParseGoBlocksFromSource(source)
+	if err != nil {
+		return nil, file.GoBlock{}, err
+	}
+	blk, err := file.FindGoBlock(blocks, blockType, name)
+	if err != nil {
+		return nil, file.GoBlock{}, err
+	}
+	return source, blk, nil
+}
+
+// formatGo concatenates the parts of the Go source and formats it.
+func formatGo(parts ...[]byte) ([]byte, error) {
+	formatted, err := format.Source(bytes.Join(parts, nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format updated Go code: %w", err)
+	}
+	return formatted, nil
+}
+
+// formatComment converts the comment text into Go line comments.
+func formatComment(comment string) string {
+	lines := strings.Split(strings.TrimSpace(comment), "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "//") {
+			lines[i] = "// " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// updateFuncGo updates the specified function or method in a Go file with new content and comment.
+// The function is identified by its name (qualified with the receiver type for methods e.g. Client.Search)
+// and only the body of the function is replaced with the content.
+// The function comment is updated if specified. Set comment to an empty string to keep the existing comment.
+func updateFuncGo(reader io.Reader, blockType, function, content, comment string) ([]byte, error) {
+	// Read the original file content and resolve the qualified name of the function
+	source, blk, err := findGoBlock(reader, blockType, function)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace the body of the function in the source so that the rest of the file is kept as it is
+	newContent := []byte(blk.Content)
+	if content != "" {
+		if _, err := parser.ParseExpr(fmt.Sprintf("func() { %s }", content)); err != nil {
+			return nil, fmt.Errorf("invalid body of function %s: %w", blk.Name, err)
+		}
+		body, err := funcBodyOffset(source, blk)
+		if err != nil {
+			return nil, err
+		}
+		newContent = []byte(string(source[blk.Start:body]) + "{\n" + strings.Trim(content, "\n") + "\n}")
+	}
+
+	start := blk.Start
+	if comment != "" {
+		start = blk.DocStart
+		newContent = append([]byte(formatComment(comment)+"\n"), newContent...)
+	}
+	return formatGo(source[:start], newContent, source[blk.End:])
+}
+
+// funcBodyOffset returns the offset of the opening brace of the body of the function block.
+func funcBodyOffset(source []byte, blk file.GoBlock) (int, error) {
+	fs := token.NewFileSet()
+	node, err := parser.ParseFile(fs, "", source, parser.ParseComments)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse Go source: %w", err)
+	}
+	for _, decl := range node.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fs.Position(fn.Pos()).Offset == blk.Start && fn.Body != nil {
+			return fs.Position(fn.Body.Lbrace).Offset, nil
+		}
+	}
+	return 0, fmt.Errorf("function %s not found", blk.Name)
+}
@@ -0,0 +1,260 @@
+package planner
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUpdateFuncGo(t *testing.T) {
+	source := `package main
+
+import "fmt"
+
+// greet receives a name and prints a greeting message.
+func greet(name string) {
+	fmt.Printf("Hello, %s!\n", name)
+}
+
+func keep() {
+	// keep the comment
+	fmt.Println(  "keep" )
+}
+`
+	tests := []struct {
+		name    string
+		content string
+		comment string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "body and comment",
+			content: "fmt.Printf(\"Hi, %s! Welcome back.\\n\", name)",
+			comment: "greet greets with a welcome message.",
+			want: `package main
+
+import "fmt"
+
+// greet greets with a welcome message.
+func greet(name string) {
+	fmt.Printf("Hi, %s! Welcome back.\n", name)
+}
+
+func keep() {
+	// keep the comment
+	fmt.Println("keep")
+}
+`,
+		},
+		{
+			name:    "multi-line body keeps the existing comment",
+			content: "\nif name == \"\" {\n\tname = \"world\"\n}\nfmt.Printf(\"Hi, %s!\\n\", name)\n",
+			want: `package main
+
+import "fmt"
+
+// greet receives a name and prints a greeting message.
+func greet(name string) {
+	if name == "" {
+		name = "world"
+	}
+	fmt.Printf("Hi, %s!\n", name)
+}
+
+func keep() {
+	// keep the comment
+	fmt.Println("keep")
+}
+`,
+		},
+		{
+			name:    "multi-line comment",
+			comment: "greet prints a greeting message.\nThe name must not be empty.",
+			want: `package main
+
+import "fmt"
+
+// greet prints a greeting message.
+// The name must not be empty.
+func greet(name string) {
+	fmt.Printf("Hello, %s!\n", name)
+}
+
+func keep() {
+	// keep the comment
+	fmt.Println("keep")
+}
+`,
+		},
+		{
+			name:    "invalid body",
+			content: "if {",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := updateFuncGo(strings.NewReader(source), "function", "greet", tt.content, tt.comment)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("updateFuncGo returned an error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAddDeclGo(t *testing.T) {
+	source := "package main\n\nfunc Keep() {}\n"
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "function with comment",
+			content: "// New is new.\nfunc New() {}",
+			want:    "package main\n\nfunc Keep() {}\n\n// New is new.\nfunc New() {}\n",
+		},
+		{
+			name:    "type",
+			content: "type Config struct {\n\tName string\n}",
+			want:    "package main\n\nfunc Keep() {}\n\ntype Config struct {\n\tName string\n}\n",
+		},
+		{
+			name:    "imports are merged",
+			content: "import (\n\t\"fmt\"\n\tstr \"strings\"\n)\n\n// New is new.\nfunc New() { fmt.Println(str.ToUpper(\"new\")) }",
+			want:    "package main\n\nimport (\n\t\"fmt\"\n\tstr \"strings\"\n)\n\nfunc Keep() {}\n\n// New is new.\nfunc New() { fmt.Println(str.ToUpper(\"new\")) }\n",
+		},
+		{
+			name:    "invalid",
+			content: "func New() {",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := addDeclGo(strings.NewReader(source), tt.content)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("addDeclGo returned an error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGoLanguage_Apply(t *testing.T) {
+	source := `package main
+
+import "fmt"
+
+// Kind is a kind.
+type Kind string
+
+const (
+	KindA Kind = "a"
+)
+
+type (
+	Client struct{}
+	Store  struct{}
+)
+
+func (c *Client) Search() { fmt.Println("client") }
+
+func (s *Store) Search() { fmt.Println("store") }
+`
+	tests := []struct {
+		name   string
+		change BlockChange
+		want   string
+	}{
+		{
+			name:   "update method",
+			change: BlockChange{Block: Block{TargetType: "method", TargetName: "Store.Search"}, NewContent: `fmt.Println("new")`},
+			want:   "func (c *Client) Search() { fmt.Println(\"client\") }\n\nfunc (s *Store) Search() {\n\tfmt.Println(\"new\")\n}\n",
+		},
+		{
+			name:   "replace type with comment",
+			change: BlockChange{Block: Block{TargetType: "type", TargetName: "Kind"}, NewContent: "type Kind int", NewComment: "Kind is a number."},
+			want:   "// Kind is a number.\ntype Kind int\n",
+		},
+		{
+			name:   "replace grouped struct",
+			change: BlockChange{Block: Block{TargetType: "struct", TargetName: "Client"}, NewContent: "type Client struct {\n\tName string\n}"},
+			want:   "\tClient struct {\n\t\tName string\n\t}\n",
+		},
+		{
+			name:   "replace const group",
+			change: BlockChange{Block: Block{TargetType: "const", TargetName: "KindA"}, NewContent: "const (\n\tKindA Kind = \"a\"\n\tKindB Kind = \"b\"\n)"},
+			want:   "\tKindB Kind = \"b\"\n",
+		},
+		{
+			name:   "replace import",
+			change: BlockChange{Block: Block{TargetType: "import", TargetName: "import"}, NewContent: "import (\n\t\"fmt\"\n\t\"os\"\n)"},
+			want:   "import (\n\t\"fmt\"\n\t\"os\"\n)\n",
+		},
+		{
+			name:   "delete method",
+			change: BlockChange{Action: ActionTypeDelete, Block: Block{TargetType: "method", TargetName: "Client.Search"}},
+			want:   "type (\n\tClient struct{}\n\tStore  struct{}\n)\n\nfunc (s *Store) Search() { fmt.Println(\"store\") }\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := goLanguage{}.Apply(strings.NewReader(source), tt.change)
+			if err != nil {
+				t.Fatalf("Apply returned an error: %v", err)
+			}
+			if !strings.Contains(string(got), tt.want) {
+				t.Errorf("expected to contain:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+
+	_, err := goLanguage{}.Apply(strings.NewReader(source), BlockChange{Block: Block{TargetType: "method", TargetName: "Unknown.Search"}})
+	if err == nil {
+		t.Errorf("expected error for unknown method")
+	}
+
+	// a function type doesn't match the methods of more than one type
+	_, err = goLanguage{}.Apply(strings.NewReader(source), BlockChange{Action: ActionTypeDelete, Block: Block{TargetType: "function", TargetName: "Search"}})
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected ambiguous error, got %v", err)
+	}
+}
+
+func TestGoLanguage_Apply_Duplicates(t *testing.T) {
+	source := "package main\n\nfunc init() { println(1) }\n\nfunc init() { println(2) }\n"
+
+	got, err := goLanguage{}.Apply(strings.NewReader(source), BlockChange{Block: Block{TargetType: "function", TargetName: "init#2"}, NewContent: "println(3)"})
+	if err != nil {
+		t.Fatalf("Apply returned an error: %v", err)
+	}
+	want := "package main\n\nfunc init() { println(1) }\n\nfunc init() {\n\tprintln(3)\n}\n"
+	if string(got) != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	// the name shared by more than one block doesn't match any of them
+	_, err = goLanguage{}.Apply(strings.NewReader(source), BlockChange{Block: Block{TargetType: "function", TargetName: "init"}, NewContent: "println(3)"})
+	if err == nil {
+		t.Errorf("expected error for duplicated name")
+	}
+}
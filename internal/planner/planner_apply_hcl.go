@@ -0,0 +1,104 @@
+package planner
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// Apply applies the change to the HCL source read from r.
+func (hclLanguage) Apply(r io.Reader, c BlockChange) ([]byte, error) {
+
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from reader: %w", err)
+	}
+	f, diag := hclwrite.ParseConfig(src, c.Block.Path, hcl.InitialPos)
+
+	if diag.HasErrors() {
+		return nil, fmt.Errorf("failed to parse HCL file: %s, error: %v", c.Block.Path, diag.Error())
+	}
+	switch c.GetAction() {
+	case ActionTypeAdd:
+		err = AddBlocks(f, c.NewContent)
+	case ActionTypeDelete:
+		err = DeleteBlock(f, c.Block.TargetType, c.Block.TargetName)
+	default:
+		err = UpdateBlock(f, c.Block.TargetType, c.Block.TargetName, c.NewContent, nil) // targetname is strings.Join(block.Labels(), ",") and newComments is not implemented yet
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s block (%s): %w", c.GetAction(), c.Block.Path, err)
+	}
+	if c.GetAction() != ActionTypeUpdate {
+		// clean up the blank lines left around the added or deleted block
+		out := blankLinesPattern.ReplaceAll(hclwrite.Format(f.Bytes()), []byte("\n\n"))
+		if !bytes.HasPrefix(src, []byte("\n")) {
+			out = bytes.TrimLeft(out, "\n")
+		}
+		return out, nil
+	}
+	return f.Bytes(), nil
+}
+
+var blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+
+// AddBlocks appends the blocks in newContent (entire blocks including the type and the labels) to the file.
+func AddBlocks(f *hclwrite.File, newContent string) error {
+	tempFile, diags := hclwrite.ParseConfig([]byte(newContent), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return fmt.Errorf("failed to parse new block content: %s", diags.Error())
+	}
+	if len(tempFile.Body().Blocks()) == 0 {
+		return fmt.Errorf("no block found in the new content")
+	}
+	for _, block := range tempFile.Body().Blocks() {
+		f.Body().AppendNewline()
+		f.Body().AppendBlock(block)
+	}
+	return nil
+}
+
+// DeleteBlock removes the block with the type and the labels joined with "," from the file.
+func DeleteBlock(f *hclwrite.File, blockType, resourceName string) error {
+	for _, block := range f.Body().Blocks() {
+		if block.Type() == blockType && strings.Join(block.Labels(), ",") == resourceName {
+			f.Body().RemoveBlock(block)
+			return nil
+		}
+	}
+	return fmt.Errorf("resource block not found: %s", resourceName)
+}
+
+// updateBlock replaces the entire content of the specified resource block with new content
+func UpdateBlock(f *hclwrite.File, blockType, resourceName string, newContent string, newComments []string) error {
+	body := f.Body()
+	blocks := body.Blocks()
+
+	for i, block := range blocks {
+		fmt.Println(i, " blockType: ", blockType, ", resourceName: ", resourceName, ", block.Type: ", block.Type(), ", block.Labels: ", strings.Join(block.Labels(), ","))
+		if block.Type() == blockType && strings.Join(block.Labels(), ",") == resourceName {
+			fmt.Println("found matched block for ", resourceName)
+			// Parse the new content into a temporary HCL file
+			tempFile, diags := hclwrite.ParseConfig([]byte(newContent), "", hcl.InitialPos)
+			if diags.HasErrors() {
+				return fmt.Errorf("failed to parse new block content: %s", diags.Error())
+			}
+
+			// Clear the existing block body and append new tokens
+			block.Body().Clear()
+			block.Body().AppendUnstructuredTokens(tempFile.Body().BuildTokens(nil))
+
+			// Add new comments
+			if len(newComments) > 0 {
+				fmt.Println("newComments is not implemented yet for HCL.")
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("resource block not found: %s", resourceName)
+}
@@ -0,0 +1,439 @@
+package planner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/symbol"
+)
+
+// maxFollowUps is the maximum number of follow-up changes generated by the consistency check.
+const maxFollowUps = 20
+
+// goDecl is a top-level Go declaration compared by the consistency check.
+type goDecl struct {
+	signature string            // e.g. func(ctx context.Context) error, type Client struct{...}
+	methods   map[string]string // method name -> signature for interfaces
+}
+
+// declChange is a change of the declaration of a Go symbol made by a change in the plan.
+type declChange struct {
+	path   string
+	name   string // e.g. NewPlanner, Client, Client.Search
+	before goDecl
+	after  *goDecl // nil if the symbol is deleted
+}
+
+// addFollowUpChanges finds the blocks affected by the changes of the Go declarations in the plan
+// (e.g. the callers of a function whose signature changes and the implementations of an interface)
+// with the reference index, and adds the changes to keep them consistent to the plan.
+// The blocks and the files already changed by the plan are not changed again. When a file with block changes in the plan
+// needs to be changed entirely, the block changes are merged into the change of the entire file.
+// The follow-up changes are not checked again, so the changes they cause are left to the review.
+func (p *Planner) addFollowUpChanges(ctx context.Context, plan *ChangesPlan, investigationResult string) error {
+	if p.referenceIndex == nil {
+		return nil
+	}
+	targets := findFollowUps(plan, p.referenceIndex)
+	if len(targets) == 0 {
+		return nil
+	}
+	if len(targets) > maxFollowUps {
+		fmt.Printf("Found %d blocks affected by the changes. Only the first %d are changed\n", len(targets), maxFollowUps)
+		targets = targets[:maxFollowUps]
+	}
+
+	var files []file.File
+	candidateBlocks := map[string][]Block{}
+	originals := map[string]string{} // path -> content of the file whose block changes are merged
+	for _, t := range targets {
+		fmt.Printf("Follow-up change: %s %s %s in %s\n", t.target.Action, t.target.TargetType, t.target.TargetName, t.target.Path)
+		if _, ok := candidateBlocks[t.target.Path]; ok {
+			continue
+		}
+		content, err := os.ReadFile(t.target.Path)
+		if err != nil {
+			return fmt.Errorf("failed to read file (%s): %w", t.target.Path, err)
+		}
+		f := file.File{Path: t.target.Path, Content: string(content)}
+		files = append(files, f)
+		fileBlock := Block{Path: f.Path, TargetType: "file", TargetName: f.Path, Content: f.Content}
+		if t.target.TargetType == "file" && hasBlockChanges(plan, f.Path) {
+			// the entire file is changed from the file with the block changes of the plan,
+			// which are replaced with the change of the entire file
+			merged, err := applyBlockChanges(plan, f.Path, content)
+			if err != nil {
+				fmt.Printf("Skip follow-up change of %s: %v\n", f.Path, err)
+				continue
+			}
+			fileBlock.Content = string(merged)
+			originals[f.Path] = f.Content
+		}
+		candidateBlocks[f.Path] = append(parseCandidateBlocks([]file.File{f})[f.Path], fileBlock)
+	}
+
+	changes, err := p.generateBlockChanges(ctx, targets, files, candidateBlocks, investigationResult, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to generate follow-up changes: %w", err)
+	}
+	for _, c := range changes {
+		// the LLM leaves the content empty if the block doesn't need to change
+		if c.GetAction() == ActionTypeUpdate && c.NewContent == "" && c.NewComment == "" {
+			fmt.Printf("No follow-up change is necessary for %s %s in %s\n", c.Block.TargetType, c.Block.TargetName, c.Block.Path)
+			continue
+		}
+		if original, ok := originals[c.Block.Path]; ok && c.Block.TargetType == "file" {
+			fmt.Printf("The changes of the blocks in %s are merged into the follow-up change of the file\n", c.Block.Path)
+			// record the file at plan time to detect the changes made after planning
+			c.Block.Content = original
+			plan.Changes = slices.DeleteFunc(plan.Changes, func(pc BlockChange) bool {
+				return pc.Block.Path == c.Block.Path && pc.Block.TargetType != "file" && pc.Decision != DecisionRejected
+			})
+		}
+		plan.Changes = append(plan.Changes, c)
+	}
+	return nil
+}
+
+// hasBlockChanges returns true if the plan changes a block in the file.
+func hasBlockChanges(plan *ChangesPlan, path string) bool {
+	for _, c := range plan.Changes {
+		if c.Block.Path == path && c.Block.TargetType != "file" && c.Decision != DecisionRejected {
+			return true
+		}
+	}
+	return false
+}
+
+// applyBlockChanges applies the changes of the blocks in the file in the plan to the content in order.
+func applyBlockChanges(plan *ChangesPlan, path string, content []byte) ([]byte, error) {
+	lang, ok := LanguageFor(path)
+	if !ok {
+		return nil, fmt.Errorf("unsupported file type: %s", path)
+	}
+	for _, c := range plan.Changes {
+		if c.Block.Path != path || c.Block.TargetType == "file" || c.Decision == DecisionRejected {
+			continue
+		}
+		var err error
+		content, err = lang.Apply(bytes.NewReader(content), c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply the change of %s %s: %w", c.Block.TargetType, c.Block.TargetName, err)
+		}
+	}
+	return content, nil
+}
+
+// findFollowUps returns the blocks to change to keep the usages and the implementations consistent with the changed declarations.
+// The targets are sorted by path and name. A file is updated entirely when a method of the implementation in it needs
+// a new signature because only the body of a function can be changed as a block.
+func findFollowUps(plan *ChangesPlan, index *symbol.Index) []*stepTarget {
+	changed := map[string]bool{} // block key or file path changed by the plan
+	for _, c := range plan.Changes {
+		if c.Block.TargetType == "file" {
+			changed[c.Block.Path] = true
+		} else {
+			changed[blockKey(c.Block)] = true
+		}
+	}
+
+	targets := map[string]*stepTarget{}
+	addTarget := func(target TargetBlock, step string) {
+		if changed[target.Path] || changed[blockKey(target.Block())] {
+			return
+		}
+		key := blockKey(target.Block())
+		if t, ok := targets[key]; ok {
+			if !containsString(t.steps, step) {
+				t.steps = append(t.steps, step)
+			}
+			return
+		}
+		targets[key] = &stepTarget{target: target, steps: []string{step}}
+	}
+
+	for _, dc := range findDeclChanges(plan) {
+		after := "(deleted)"
+		if dc.after != nil {
+			after = dc.after.signature
+		}
+		// usages
+		step := fmt.Sprintf("The plan changes the declaration of %s in %s. Update this block to use the new declaration if it uses %s. Leave the new content empty if no change is necessary.\nBefore: %s\nAfter: %s", dc.name, dc.path, dc.name, dc.before.signature, after)
+		for _, blk := range referencingBlocks(index, dc.name) {
+			addTarget(TargetBlock{Action: ActionTypeUpdate, Path: blk.Path, TargetType: blk.TargetType, TargetName: blk.TargetName}, step)
+		}
+
+		// implementations of the interface
+		if dc.before.methods == nil || dc.after == nil || dc.after.methods == nil {
+			continue
+		}
+		var oldMethods []string
+		for m := range dc.before.methods {
+			oldMethods = append(oldMethods, m)
+		}
+		sort.Strings(oldMethods)
+		implementations := implementationsOf(index, oldMethods)
+		for _, m := range oldMethods {
+			if sig, ok := dc.after.methods[m]; ok && sig == dc.before.methods[m] {
+				continue
+			}
+			step := fmt.Sprintf("The plan changes the method %s of the interface %s in %s. Update the implementation of the method in this file to match the new interface.\nBefore: %s\nAfter: %s", m, dc.name, dc.path, dc.before.signature, dc.after.signature)
+			callStep := fmt.Sprintf("The plan changes the method %s of the interface %s in %s. Update this block to call the new method if it calls %s of %s. Leave the new content empty if no change is necessary.\nBefore: %s\nAfter: %s", m, dc.name, dc.path, m, dc.name, dc.before.signature, dc.after.signature)
+			for _, blk := range referencingBlocks(index, m) {
+				addTarget(TargetBlock{Action: ActionTypeUpdate, Path: blk.Path, TargetType: blk.TargetType, TargetName: blk.TargetName}, callStep)
+			}
+			for _, impl := range implementations {
+				if def := index.Definitions(impl + "." + m); len(def) > 0 && filepath.Ext(def[0].Path) == ".go" {
+					addTarget(TargetBlock{Action: ActionTypeUpdate, Path: def[0].Path, TargetType: "file", TargetName: def[0].Path}, step)
+				}
+			}
+		}
+		for m := range dc.after.methods {
+			if _, ok := dc.before.methods[m]; ok || len(oldMethods) == 0 {
+				continue
+			}
+			for _, impl := range implementations {
+				def := index.Definitions(impl + "." + oldMethods[0])
+				if len(def) == 0 || len(index.Definitions(impl+"."+m)) > 0 {
+					continue
+				}
+				step := fmt.Sprintf("The plan adds the method %s to the interface %s in %s. Add the method to %s to implement the new interface.\nInterface: %s", m, dc.name, dc.path, impl, dc.after.signature)
+				addTarget(TargetBlock{Action: ActionTypeAdd, Path: def[0].Path, TargetType: file.GoBlockMethod, TargetName: impl + "." + m}, step)
+			}
+		}
+	}
+
+	// the entire file is updated with all the steps for the blocks in it
+	var result []*stepTarget
+	for _, t := range targets {
+		if t.target.TargetType == "file" {
+			for _, other := range targets {
+				if other != t && other.target.Path == t.target.Path {
+					for _, step := range other.steps {
+						if !containsString(t.steps, step) {
+							t.steps = append(t.steps, step)
+						}
+					}
+				}
+			}
+			result = append(result, t)
+		} else if _, ok := targets[t.target.Path+"\x00file\x00"+t.target.Path]; !ok {
+			result = append(result, t)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].target.Path != result[j].target.Path {
+			return result[i].target.Path < result[j].target.Path
+		}
+		return result[i].target.TargetName < result[j].target.TargetName
+	})
+	for _, t := range result {
+		sort.Strings(t.steps)
+	}
+	return result
+}
+
+// findDeclChanges compares the Go declarations before and after each change in the plan.
+// Updates of function bodies don't change the declarations and new declarations don't break anything.
+func findDeclChanges(plan *ChangesPlan) []declChange {
+	var changes []declChange
+	for _, c := range plan.Changes {
+		if filepath.Ext(c.Block.Path) != ".go" || c.GetAction() == ActionTypeAdd {
+			continue
+		}
+		var before, after map[string]goDecl
+		switch {
+		case c.Block.TargetType == "file":
+			content, err := os.ReadFile(c.Block.Path)
+			if err != nil {
+				continue
+			}
+			before = parseGoDecls(string(content))
+			if c.GetAction() != ActionTypeDelete {
+				after = parseGoDecls(c.NewContent)
+			}
+		case c.GetAction() == ActionTypeDelete:
+			before = parseGoDecls(c.Block.Content)
+		case c.Block.TargetType == file.GoBlockFunction || c.Block.TargetType == file.GoBlockMethod, c.NewContent == "":
+			continue
+		default:
+			before = parseGoDecls(c.Block.Content)
+			after = parseGoDecls(c.NewContent)
+		}
+
+		var names []string
+		for name := range before {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			dc := declChange{path: c.Block.Path, name: name, before: before[name]}
+			if a, ok := after[name]; ok {
+				if a.signature == dc.before.signature {
+					continue
+				}
+				dc.after = &a
+			}
+			changes = append(changes, dc)
+		}
+	}
+	return changes
+}
+
+// parseGoDecls parses the Go file or the declarations into the declarations by name.
+// Methods are qualified with the receiver type. The type spec in a grouped declaration may lack the keyword.
+func parseGoDecls(src string) map[string]goDecl {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, parser.SkipObjectResolution)
+	if err != nil {
+		if f, err = parser.ParseFile(token.NewFileSet(), "", "package p\n"+src, parser.SkipObjectResolution); err != nil {
+			if f, err = parser.ParseFile(token.NewFileSet(), "", "package p\ntype "+src, parser.SkipObjectResolution); err != nil {
+				return nil
+			}
+		}
+	}
+
+	decls := map[string]goDecl{}
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			signature := types.ExprString(d.Type)
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				signature = fmt.Sprintf("func (%s) %s%s", types.ExprString(d.Recv.List[0].Type), d.Name.Name, strings.TrimPrefix(signature, "func"))
+			} else {
+				signature = fmt.Sprintf("func %s%s", d.Name.Name, strings.TrimPrefix(signature, "func"))
+			}
+			decls[file.GoFuncName(d)] = goDecl{signature: signature}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					decl := goDecl{signature: fmt.Sprintf("type %s %s", s.Name.Name, types.ExprString(s.Type))}
+					if s.TypeParams != nil {
+						decl.signature = fmt.Sprintf("type %s[%s] %s", s.Name.Name, fieldListString(s.TypeParams), types.ExprString(s.Type))
+					}
+					if iface, ok := s.Type.(*ast.InterfaceType); ok {
+						decl.methods = map[string]string{}
+						for _, m := range iface.Methods.List {
+							for _, name := range m.Names {
+								decl.methods[name.Name] = types.ExprString(m.Type)
+							}
+						}
+					}
+					decls[s.Name.Name] = decl
+				case *ast.ValueSpec:
+					for _, name := range s.Names {
+						if name.Name == "_" {
+							continue
+						}
+						signature := fmt.Sprintf("%s %s", d.Tok, name.Name)
+						if s.Type != nil {
+							signature += " " + types.ExprString(s.Type)
+						}
+						decls[name.Name] = goDecl{signature: signature}
+					}
+				}
+			}
+		}
+	}
+	return decls
+}
+
+func fieldListString(fields *ast.FieldList) string {
+	var parts []string
+	for _, f := range fields.List {
+		var names []string
+		for _, n := range f.Names {
+			names = append(names, n.Name)
+		}
+		parts = append(parts, strings.TrimSpace(strings.Join(names, ", ")+" "+types.ExprString(f.Type)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// referencingBlocks returns the Go blocks that reference the symbol in the files found by the index.
+// Methods are referenced by their names without the receiver type.
+func referencingBlocks(index *symbol.Index, name string) []Block {
+	ident := name
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		ident = name[i+1:]
+	}
+	var blocks []Block
+	for _, path := range index.References(ident) {
+		if filepath.Ext(path) != ".go" {
+			continue
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+		var offsets []int
+		ast.Inspect(f, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && id.Name == ident {
+				offsets = append(offsets, fset.Position(id.Pos()).Offset)
+			}
+			return true
+		})
+		goBlocks, err := file.ParseGoBlocksFromSource(src)
+		if err != nil {
+			continue
+		}
+		for _, b := range goBlocks {
+			if b.Type == file.GoBlockImport || b.Name == name {
+				continue
+			}
+			for _, offset := range offsets {
+				if b.Start <= offset && offset < b.End {
+					blocks = append(blocks, Block{Path: path, TargetType: b.Type, TargetName: b.Name, Content: b.Content})
+					break
+				}
+			}
+		}
+	}
+	return blocks
+}
+
+// implementationsOf returns the receiver types that have all the methods.
+func implementationsOf(index *symbol.Index, methods []string) []string {
+	if len(methods) == 0 {
+		return nil
+	}
+	count := map[string]int{}
+	for _, m := range methods {
+		for _, def := range index.Methods(m) {
+			count[strings.TrimSuffix(def.Name, "."+m)]++
+		}
+	}
+	var receivers []string
+	for t, n := range count {
+		if n == len(methods) {
+			receivers = append(receivers, t)
+		}
+	}
+	sort.Strings(receivers)
+	return receivers
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
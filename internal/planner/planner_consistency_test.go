@@ -0,0 +1,222 @@
+package planner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/symbol"
+	"github.com/stretchr/testify/assert"
+)
+
+const storeGo = `package store
+
+// Store stores values.
+type Store interface {
+	Get(key string) string
+}
+
+func NewStore() Store {
+	return &memStore{}
+}
+`
+
+const memStoreGo = `package store
+
+type memStore struct{}
+
+func (m *memStore) Get(key string) string {
+	return key
+}
+`
+
+const useGo = `package store
+
+func use(s Store) string {
+	return s.Get("key")
+}
+
+func unrelated() {}
+`
+
+func setUpConsistency(t *testing.T) (string, *symbol.Index) {
+	dir := t.TempDir()
+	var paths []string
+	for name, content := range map[string]string{"store.go": storeGo, "mem_store.go": memStoreGo, "use.go": useGo} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+		paths = append(paths, path)
+	}
+	return dir, symbol.BuildIndex(paths, file.DefaultFileReader{})
+}
+
+func TestFindFollowUps(t *testing.T) {
+	dir, index := setUpConsistency(t)
+	storeFile, memStoreFile, useFile := filepath.Join(dir, "store.go"), filepath.Join(dir, "mem_store.go"), filepath.Join(dir, "use.go")
+	storeBlock := Block{Path: storeFile, TargetType: "interface", TargetName: "Store", Content: "type Store interface {\n\tGet(key string) string\n}"}
+
+	tests := []struct {
+		name    string
+		changes []BlockChange
+		want    []TargetBlock
+	}{
+		{
+			name:    "function body",
+			changes: []BlockChange{{Block: Block{Path: storeFile, TargetType: "function", TargetName: "NewStore"}, NewContent: "return nil"}},
+		},
+		{
+			name:    "comment only",
+			changes: []BlockChange{{Block: storeBlock, NewComment: "Store stores strings."}},
+		},
+		{
+			name: "interface method signature",
+			changes: []BlockChange{
+				{Block: storeBlock, NewContent: "type Store interface {\n\tGet(ctx context.Context, key string) string\n}"},
+			},
+			want: []TargetBlock{
+				{Action: ActionTypeUpdate, Path: memStoreFile, TargetType: "file", TargetName: memStoreFile},
+				{Action: ActionTypeUpdate, Path: storeFile, TargetType: "function", TargetName: "NewStore"},
+				{Action: ActionTypeUpdate, Path: useFile, TargetType: "function", TargetName: "use"},
+			},
+		},
+		{
+			name: "interface method added",
+			changes: []BlockChange{
+				{Block: storeBlock, NewContent: "type Store interface {\n\tGet(key string) string\n\tPut(key, value string)\n}"},
+			},
+			want: []TargetBlock{
+				{Action: ActionTypeAdd, Path: memStoreFile, TargetType: "method", TargetName: "memStore.Put"},
+				{Action: ActionTypeUpdate, Path: storeFile, TargetType: "function", TargetName: "NewStore"},
+				{Action: ActionTypeUpdate, Path: useFile, TargetType: "function", TargetName: "use"},
+			},
+		},
+		{
+			name: "deleted function",
+			changes: []BlockChange{
+				{Action: ActionTypeDelete, Block: Block{Path: memStoreFile, TargetType: "method", TargetName: "memStore.Get", Content: "func (m *memStore) Get(key string) string {\n\treturn key\n}"}},
+			},
+			want: []TargetBlock{
+				{Action: ActionTypeUpdate, Path: storeFile, TargetType: "interface", TargetName: "Store"},
+				{Action: ActionTypeUpdate, Path: useFile, TargetType: "function", TargetName: "use"},
+			},
+		},
+		{
+			name: "the changed blocks and files are skipped",
+			changes: []BlockChange{
+				{Block: storeBlock, NewContent: "type Store interface {\n\tGet(ctx context.Context, key string) string\n}"},
+				{Block: Block{Path: storeFile, TargetType: "function", TargetName: "NewStore"}, NewContent: "return nil"},
+				{Block: Block{Path: memStoreFile, TargetType: "file", TargetName: memStoreFile}, NewContent: memStoreGo},
+			},
+			want: []TargetBlock{
+				{Action: ActionTypeUpdate, Path: useFile, TargetType: "function", TargetName: "use"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []TargetBlock
+			for _, target := range findFollowUps(&ChangesPlan{Changes: tt.changes}, index) {
+				got = append(got, target.target)
+				assert.NotEmpty(t, target.steps)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseGoDecls(t *testing.T) {
+	decls := parseGoDecls(storeGo + memStoreGo[len("package store\n"):] + "\nconst (\n\tA = 1\n\tB int = 2\n)\n")
+	assert.Equal(t, map[string]goDecl{
+		"Store":        {signature: "type Store interface{Get(key string) string}", methods: map[string]string{"Get": "func(key string) string"}},
+		"NewStore":     {signature: "func NewStore() Store"},
+		"memStore":     {signature: "type memStore struct{}"},
+		"memStore.Get": {signature: "func (*memStore) Get(key string) string"},
+		"A":            {signature: "const A"},
+		"B":            {signature: "const B int"},
+	}, decls)
+
+	// type spec in a grouped declaration
+	assert.Equal(t, map[string]goDecl{"Kind": {signature: "type Kind string"}}, parseGoDecls("Kind string"))
+}
+
+const newMemStoreGo = `package store
+
+type memStore struct{}
+
+func (m *memStore) Get(ctx context.Context, key string) string {
+	return key + "!"
+}
+`
+
+// followUpClient returns the new content for the function use and the file mem_store.go with the change of the plan, and no change for the others.
+type followUpClient struct {
+	llm.DummyClient
+}
+
+func (c followUpClient) GenerateCompletion(ctx context.Context, messages []llm.Message, schema llm.Schema) (string, error) {
+	last := messages[len(messages)-1].Content
+	if strings.Contains(last, "function 'use'") {
+		return `{"new_content": "return s.Get(context.Background(), \"key\")"}`, nil
+	}
+	if strings.Contains(last, "entire file") && strings.Contains(last, `return key + "!"`) {
+		content, err := json.Marshal(ChangeDiff{NewContent: newMemStoreGo})
+		return string(content), err
+	}
+	return `{"new_content": ""}`, nil
+}
+
+func TestAddFollowUpChanges(t *testing.T) {
+	dir, index := setUpConsistency(t)
+	storeFile := filepath.Join(dir, "store.go")
+	change := BlockChange{
+		Block:      Block{Path: storeFile, TargetType: "interface", TargetName: "Store", Content: "type Store interface {\n\tGet(key string) string\n}"},
+		NewContent: "type Store interface {\n\tGet(ctx context.Context, key string) string\n}",
+	}
+
+	// disabled without the index
+	plan := &ChangesPlan{Changes: []BlockChange{change}}
+	assert.NoError(t, NewPlanner(followUpClient{}, &ent.Client{}).addFollowUpChanges(context.Background(), plan, ""))
+	assert.Len(t, plan.Changes, 1)
+
+	plan = &ChangesPlan{Changes: []BlockChange{change}}
+	planner := NewPlanner(followUpClient{}, &ent.Client{}, WithConsistencyCheck(index))
+	assert.NoError(t, planner.addFollowUpChanges(context.Background(), plan, ""))
+	if assert.Len(t, plan.Changes, 2) { // the changes without content are dropped
+		assert.Equal(t, "use", plan.Changes[1].Block.TargetName)
+		assert.Equal(t, `return s.Get(context.Background(), "key")`, plan.Changes[1].NewContent)
+		assert.NotEmpty(t, plan.Changes[1].FileHash)
+	}
+}
+
+func TestAddFollowUpChanges_BlockChangesInFile(t *testing.T) {
+	dir, index := setUpConsistency(t)
+	storeFile, memStoreFile := filepath.Join(dir, "store.go"), filepath.Join(dir, "mem_store.go")
+	plan := &ChangesPlan{Changes: []BlockChange{
+		{
+			Block:      Block{Path: storeFile, TargetType: "interface", TargetName: "Store", Content: "type Store interface {\n\tGet(key string) string\n}"},
+			NewContent: "type Store interface {\n\tGet(ctx context.Context, key string) string\n}",
+		},
+		{Block: Block{Path: memStoreFile, TargetType: "method", TargetName: "memStore.Get"}, NewContent: `return key + "!"`},
+	}}
+
+	// the block change in the implementation's file is merged into the follow-up change of the file
+	planner := NewPlanner(followUpClient{}, &ent.Client{}, WithConsistencyCheck(index))
+	assert.NoError(t, planner.addFollowUpChanges(context.Background(), plan, ""))
+	var paths []string
+	for _, c := range plan.Changes {
+		paths = append(paths, fmt.Sprintf("%s %s", c.Block.TargetType, filepath.Base(c.Block.Path)))
+	}
+	assert.Equal(t, []string{"interface store.go", "file mem_store.go", "function use.go"}, paths)
+	assert.Equal(t, newMemStoreGo, plan.Changes[1].NewContent)
+	assert.Equal(t, memStoreGo, plan.Changes[1].Block.Content) // the file at plan time
+	assert.Empty(t, ValidatePlan(plan))
+}
@@ -0,0 +1,84 @@
+package planner
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nakamasato/aicoder/internal/file"
+)
+
+// Drift is the change made to the file of a BlockChange after the plan was generated.
+type Drift struct {
+	Path string
+	// Original is the content of the target block (the entire file for TargetType file) at plan time.
+	Original string
+	// Current is the current content of the target block. Empty if the block or the file no longer exists.
+	Current string
+	// BlockChanged is true if the target block itself was changed. Otherwise, only the other parts of the file were changed
+	// and the change can be applied safely.
+	BlockChanged bool
+}
+
+func (d Drift) String() string {
+	if !d.BlockChanged {
+		return fmt.Sprintf("%s has been changed since the plan was generated but the target block is not changed", d.Path)
+	}
+	return fmt.Sprintf("the target block in %s has been changed since the plan was generated", d.Path)
+}
+
+// DetectDrift compares the current file with the hash and the block content recorded in the change at plan time.
+// nil is returned if the file is not changed or nothing was recorded (e.g. new files or plans generated by older versions).
+func DetectDrift(c BlockChange) (*Drift, error) {
+	if c.FileHash == "" {
+		return nil, nil
+	}
+	path := c.Block.Path
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Drift{Path: path, Original: c.Block.Content, BlockChanged: true}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read file (%s): %w", path, err)
+	}
+	if file.Hash(data) == c.FileHash {
+		return nil, nil
+	}
+
+	drift := &Drift{Path: path, Original: c.Block.Content}
+	if c.Block.TargetType == "file" {
+		drift.Current = string(data)
+		drift.BlockChanged = true
+		return drift, nil
+	}
+	if c.GetAction() == ActionTypeAdd {
+		// the new block is appended regardless of the other blocks
+		return drift, nil
+	}
+
+	lang, ok := LanguageFor(path)
+	if !ok {
+		return nil, fmt.Errorf("unsupported file type: %s", path)
+	}
+	blocks, err := lang.ParseBlocks(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if blk, ok := findBlock(blocks, TargetBlock{Path: path, TargetType: c.Block.TargetType, TargetName: c.Block.TargetName}); ok {
+		drift.Current = blk.Content
+	}
+	// the block content is not recorded in the plans generated by older versions
+	drift.BlockChanged = c.Block.Content == "" || drift.Current != c.Block.Content
+	return drift, nil
+}
+
+// MergeDrift merges the changes made to the file after planning into the new content of the entire file change.
+// conflict is true if the changes overlap with the plan.
+func MergeDrift(c BlockChange, drift *Drift) (merged string, conflict bool, err error) {
+	if c.Block.TargetType != "file" || c.GetAction() != ActionTypeUpdate || drift.Current == "" {
+		return "", true, nil
+	}
+	data, conflict, err := file.Merge3([]byte(drift.Original), []byte(drift.Current), []byte(c.NewContent))
+	if err != nil {
+		return "", false, err
+	}
+	return string(data), conflict, nil
+}
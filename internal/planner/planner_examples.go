@@ -11,14 +11,14 @@ import (
 )
 
 type ActionPlanExample struct {
-	Goal string
-	Plan ActionPlan
+	Goal string     `json:"goal"`
+	Plan ActionPlan `json:"plan"`
 }
 
 type InvestigationResultExample struct {
-	Goal   string
-	Files  []file.File
-	Result InvestigationResult
+	Goal   string              `json:"goal"`
+	Files  []file.File         `json:"files"`
+	Result InvestigationResult `json:"result"`
 }
 
 
@@ -11,37 +11,68 @@ import (
 
 	"github.com/nakamasato/aicoder/internal/file"
 	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/prompt"
+	"github.com/nakamasato/aicoder/internal/tool"
 )
 
+const TOOLS_PROMPT = `You can call the tools to collect the information that is not in the relevant files above.
+e.g. search for other relevant files, grep for the usages of a function, read a part of a file or list the symbols defined in a file.
+Please stop calling the tools once you have enough information for the investigation theme.`
+
 //go:embed templates/investigation_prompt.tmpl
 var InvestigationPromptTemplate string
 
+// investigationPromptData is the data passed to the investigation prompt template.
+type investigationPromptData struct {
+	OriginalQuery string
+	RelevantFiles []file.File
+	Examples      []InvestigationResultExample
+}
+
+var templateFuncs = template.FuncMap{
+	"add": func(a, b int) int { return a + b },
+}
+
+func init() {
+	prompt.RegisterTemplate(prompt.Spec{
+		Name:    "investigation_prompt.tmpl",
+		Default: InvestigationPromptTemplate,
+		Data: investigationPromptData{
+			RelevantFiles: []file.File{{}},
+			Examples:      []InvestigationResultExample{{Files: []file.File{{}}}},
+		},
+		Funcs: templateFuncs,
+	})
+	prompt.RegisterExamples(prompt.ExampleSpec{Name: "action_plan.json", Default: DefaultActionPlanExamples})
+	prompt.RegisterExamples(prompt.ExampleSpec{Name: "investigation.json", Default: DefaultInvestigationExamples})
+}
+
 type InvestigationResult struct {
 	TargetFiles    []string `json:"target_files" jsonschema_description:"List of files that are necessary to modify. The files generated by aicoder must not be included. e.g. repo_structure.json, repo_summary.json, etc."`
 	ReferenceFiles []string `json:"reference_files" jsonschema_description:"List of files that are necessary to refer to determine modification."`
 	Result         string   `json:"result" jsonschema_description:"The result of the investigation. Please provide the necessary information or pieces of contents from the relevant files."`
 }
 
+// Investigation is the result of an investigation step.
+type Investigation struct {
+	Step   string              `json:"step" jsonschema_description:"The investigation step"`
+	Result InvestigationResult `json:"result" jsonschema_description:"The result of the investigation step"`
+}
+
 func (ir InvestigationResult) String() string {
 	return fmt.Sprintf("Target Files: %v\nReference Files: %v\nResult: %s", ir.TargetFiles, ir.ReferenceFiles, ir.Result)
 }
 
-func generateInvestigationPrompt(query string, files []file.File, examples []InvestigationResultExample) (string, error) {
+func generateInvestigationPrompt(templatefile, query string, files []file.File, examples []InvestigationResultExample) (string, error) {
 	// Prepare data for the template
-	data := struct {
-		OriginalQuery string
-		RelevantFiles []file.File
-		Examples      []InvestigationResultExample
-	}{
+	data := investigationPromptData{
 		OriginalQuery: query,
 		RelevantFiles: files,
 		Examples:      examples,
 	}
 
 	// Execute the template
-	tmpl, err := template.New("examples").Funcs(template.FuncMap{
-		"add": func(a, b int) int { return a + b },
-	}).Parse(InvestigationPromptTemplate)
+	tmpl, err := template.New("examples").Funcs(templateFuncs).Parse(templatefile)
 	if err != nil {
 		return "", err
 	}
@@ -54,29 +85,44 @@ func generateInvestigationPrompt(query string, files []file.File, examples []Inv
 }
 
 // 3. Investigation Step
-func (p *Planner) executeInvestigation(ctx context.Context, query string, steps []string, files []file.File) (string, error) {
-	var investigationResultStr strings.Builder
+func (p *Planner) executeInvestigation(ctx context.Context, query string, steps []string, files []file.File) ([]Investigation, error) {
+	templatefile, err := p.prompts.Template("investigation_prompt.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load investigation prompt template: %w", err)
+	}
+	examples, err := prompt.Examples[[]InvestigationResultExample](p.prompts, "investigation.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load investigation examples: %w", err)
+	}
+
+	var investigations []Investigation
 	for i, step := range steps {
 		fmt.Printf("Investigation Step %d: %s\n", i+1, step)
 		// identify files to check for collect information
 		investigationFiles, err := p.removeUnrelevantFiles(ctx, step, files)
 		if err != nil {
-			return "", fmt.Errorf("failed to remove irrelevant files: %w", err)
+			return nil, fmt.Errorf("failed to remove irrelevant files: %w", err)
 		}
 		fmt.Printf("Investigation Step %d: Relevant files: %d\n", i+1, len(investigationFiles))
 
 		// generate completion for investigation step
-		prompt, err := generateInvestigationPrompt(query, investigationFiles, DefaultInvestigationExamples)
+		prompt, err := generateInvestigationPrompt(templatefile, query, investigationFiles, examples)
 		if err != nil {
-			return "", fmt.Errorf("failed to generate prompt for investigation step %d: %w", i+1, err)
+			return nil, fmt.Errorf("failed to generate prompt for investigation step %d: %w", i+1, err)
 		}
 
-		res, err := p.llmClient.GenerateCompletion(ctx, []llm.Message{
+		messages := []llm.Message{
 			{Role: llm.RoleSystem, Content: prompt},
 			{Role: llm.RoleUser, Content: fmt.Sprintf(`Investigation theme: %s`, step)},
-		}, InvestigationResultSchemaParam)
+		}
+		messages, err = p.callTools(ctx, messages)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call tools for investigation step %d: %w", i+1, err)
+		}
+
+		res, err := p.llmClient.GenerateCompletion(ctx, messages, InvestigationResultSchemaParam)
 		if err != nil {
-			return "", fmt.Errorf("failed to generate completion for investigation step %d: %w", i+1, err)
+			return nil, fmt.Errorf("failed to generate completion for investigation step %d: %w", i+1, err)
 		}
 		fmt.Printf(`Investigation Step %d:
 	step: %s
@@ -84,10 +130,85 @@ func (p *Planner) executeInvestigation(ctx context.Context, query string, steps
 
 		var result InvestigationResult
 		if err = json.Unmarshal([]byte(res), &result); err != nil {
-			return "", fmt.Errorf("failed to generate completion for investigation step %d: %w", i+1, err)
+			return nil, fmt.Errorf("failed to generate completion for investigation step %d: %w", i+1, err)
 		}
-		investigationResultStr.WriteString(fmt.Sprintf("\n--- %d ---\nInvestigation: %s\nTarget files:\n%s\nReference files:\n%s\nResult:\n%s\n--- %d end ---\n", i, step, result.TargetFiles, result.ReferenceFiles, result.Result, i))
+		investigations = append(investigations, Investigation{Step: step, Result: result})
 	}
 
-	return investigationResultStr.String(), nil
+	return investigations, nil
+}
+
+// callTools lets the LLM call the tools until it stops calling them or the maximum number of rounds is reached.
+// The tool calls are appended to the messages as an assistant message followed by their results
+// so that the investigation result is generated with both.
+func (p *Planner) callTools(ctx context.Context, messages []llm.Message) ([]llm.Message, error) {
+	if len(p.tools) == 0 {
+		return messages, nil
+	}
+	messages = append(messages, llm.Message{Role: llm.RoleSystem, Content: TOOLS_PROMPT})
+	definitions := tool.Definitions(p.tools)
+	for round := 1; round <= p.toolRounds; round++ {
+		calls, err := p.llmClient.GenerateFunctionCalling(ctx, messages, definitions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate function calling (round %d): %w", round, err)
+		}
+		if len(calls) == 0 {
+			break
+		}
+		formatted := make([]string, len(calls))
+		for i, call := range calls {
+			formatted[i] = "- " + tool.FormatCall(call)
+		}
+		messages = append(messages, llm.Message{Role: llm.RoleAssistant, Content: "Calling the tools:\n" + strings.Join(formatted, "\n")})
+		for _, call := range calls {
+			fmt.Printf("Tool call (round %d): %s\n", round, tool.FormatCall(call))
+			result := tool.Call(ctx, p.tools, call)
+			messages = append(messages, llm.Message{Role: llm.RoleSystem, Content: fmt.Sprintf("Result of %s:\n%s", tool.FormatCall(call), result)})
+		}
+	}
+	return messages, nil
+}
+
+// addTargetFiles adds the target files found by the investigation to the files and the candidate blocks
+// so that the files not retrieved in advance can be changed. Files that cannot be read are skipped.
+// The paths outside of the repository (absolute paths or paths with ../) are skipped so that the files are not passed to the LLM.
+func addTargetFiles(investigations []Investigation, files []file.File, candidateBlocks map[string][]Block) []file.File {
+	known := make(map[string]bool)
+	for _, f := range files {
+		known[f.Path] = true
+	}
+	for _, inv := range investigations {
+		for _, path := range inv.Result.TargetFiles {
+			path, err := file.CleanPath(path)
+			if err != nil {
+				fmt.Printf("invalid target file found by investigation. skip: %v\n", err)
+				continue
+			}
+			if known[path] {
+				continue
+			}
+			known[path] = true
+			content, err := file.ReadContent(path)
+			if err != nil {
+				fmt.Printf("failed to read target file found by investigation. skip: %v\n", err)
+				continue
+			}
+			fmt.Printf("Target file found by investigation: %s\n", path)
+			f := file.File{Path: path, Content: content}
+			files = append(files, f)
+			for path, blocks := range parseCandidateBlocks([]file.File{f}) {
+				candidateBlocks[path] = append(candidateBlocks[path], blocks...)
+			}
+		}
+	}
+	return files
+}
+
+// investigationsString converts the investigation results into the string passed to the prompts.
+func investigationsString(investigations []Investigation) string {
+	var investigationResultStr strings.Builder
+	for i, inv := range investigations {
+		investigationResultStr.WriteString(fmt.Sprintf("\n--- %d ---\nInvestigation: %s\nTarget files:\n%s\nReference files:\n%s\nResult:\n%s\n--- %d end ---\n", i, inv.Step, inv.Result.TargetFiles, inv.Result.ReferenceFiles, inv.Result.Result, i))
+	}
+	return investigationResultStr.String()
 }
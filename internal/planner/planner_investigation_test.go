@@ -1,9 +1,17 @@
 package planner
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/nakamasato/aicoder/ent"
 	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/prompt"
+	"github.com/nakamasato/aicoder/internal/tool"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGenerateInvestigationPrompt(t *testing.T) {
@@ -79,7 +87,7 @@ The output is the information that is necessary to determine the actual file cha
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := generateInvestigationPrompt(tt.query, tt.files, tt.examples)
+			got, err := generateInvestigationPrompt(InvestigationPromptTemplate, tt.query, tt.files, tt.examples)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("generateInvestigationPrompt() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -91,3 +99,128 @@ The output is the information that is necessary to determine the actual file cha
 		})
 	}
 }
+
+func TestPromptOverrides(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "examples"), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	files := map[string]string{
+		"investigation_prompt.tmpl": "{{.OriginalQuery}}{{range $i, $f := .RelevantFiles}}{{add $i 1}}: {{$f.Path}}{{end}}{{range .Examples}}{{.Goal}}{{end}}",
+		"examples/action_plan.json": `[{"goal": "Add a command", "plan": {"investigate_steps": ["Check cmd"], "change_steps": ["Add cmd/foo"]}}]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	loader := prompt.NewLoader(dir)
+	assert.NoError(t, loader.Validate())
+
+	examples, err := prompt.Examples[[]ActionPlanExample](loader, "action_plan.json")
+	assert.NoError(t, err)
+	assert.Equal(t, []ActionPlanExample{{Goal: "Add a command", Plan: ActionPlan{InvestigateSteps: []string{"Check cmd"}, ChangeSteps: []string{"Add cmd/foo"}}}}, examples)
+	investigationExamples, err := prompt.Examples[[]InvestigationResultExample](loader, "investigation.json")
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultInvestigationExamples, investigationExamples)
+
+	templatefile, err := loader.Template("investigation_prompt.tmpl")
+	assert.NoError(t, err)
+	got, err := generateInvestigationPrompt(templatefile, "query", []file.File{{Path: "main.go"}}, investigationExamples)
+	assert.NoError(t, err)
+	assert.Equal(t, "query1: main.go"+DefaultInvestigationExamples[0].Goal, got)
+}
+
+// toolCallingClient calls the tool with the arguments once per investigation step and records the messages of the completions.
+type toolCallingClient struct {
+	recordingClient
+	call  llm.ToolCall
+	calls int
+}
+
+func (c *toolCallingClient) GenerateFunctionCalling(ctx context.Context, messages []llm.Message, tools []llm.Tool) ([]llm.ToolCall, error) {
+	c.calls++
+	if c.calls%2 == 0 {
+		return nil, nil
+	}
+	return []llm.ToolCall{c.call}, nil
+}
+
+func TestExecuteInvestigation_Tools(t *testing.T) {
+	client := &toolCallingClient{
+		recordingClient: recordingClient{DummyClient: llm.DummyClient{ReturnValue: `{"target_files": ["main.go"], "reference_files": [], "result": "NewClient is defined in main.go"}`}},
+		call:            llm.ToolCall{FunctionName: "read_file", Arguments: map[string]interface{}{"path": "main.go", "start_line": float64(1), "end_line": float64(0)}},
+	}
+	reader := file.MockFileReader{Content: "func NewClient() {}"}
+
+	// without tools
+	planner := NewPlanner(client, &ent.Client{})
+	investigations, err := planner.executeInvestigation(context.Background(), "query", []string{"Find NewClient"}, nil)
+	assert.NoError(t, err)
+	assert.Len(t, investigations, 1)
+	assert.Equal(t, 0, client.calls)
+	assert.Len(t, client.messages[0], 2)
+
+	// the tool results are passed to the completion
+	client.messages = nil
+	planner = NewPlanner(client, &ent.Client{}, WithTools(3, tool.NewReadFile(reader, []string{"main.go"})))
+	investigations, err = planner.executeInvestigation(context.Background(), "query", []string{"Find NewClient", "Find the callers"}, nil)
+	assert.NoError(t, err)
+	assert.Len(t, investigations, 2)
+	assert.Equal(t, []string{"main.go"}, investigations[0].Result.TargetFiles)
+	assert.Equal(t, 4, client.calls) // a tool call and a stop per step
+	for _, messages := range client.messages {
+		// the call is passed as the assistant message before its result
+		call := messages[len(messages)-2]
+		assert.Equal(t, llm.RoleAssistant, call.Role)
+		assert.Equal(t, "Calling the tools:\n- read_file({\"end_line\":0,\"path\":\"main.go\",\"start_line\":1})", call.Content)
+		last := messages[len(messages)-1]
+		assert.Equal(t, "Result of read_file({\"end_line\":0,\"path\":\"main.go\",\"start_line\":1}):\n1: func NewClient() {}\n", last.Content)
+	}
+
+	// the rounds are limited
+	client.calls = 0
+	client.call = llm.ToolCall{FunctionName: "unknown"}
+	planner = NewPlanner(client, &ent.Client{}, WithTools(1, tool.NewReadFile(reader, []string{"main.go"})))
+	_, err = planner.executeInvestigation(context.Background(), "query", []string{"Find NewClient"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.calls)
+}
+
+func TestAddTargetFiles(t *testing.T) {
+	dir := t.TempDir()
+	repo := filepath.Join(dir, "repo")
+	if err := os.Mkdir(repo, 0755); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+	for path, content := range map[string]string{filepath.Join(repo, "main.go"): "package main\n\nfunc main() {}\n", filepath.Join(repo, "README.md"): "# README\n", filepath.Join(dir, "secret.txt"): "secret\n"} {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(repo); err != nil {
+		t.Fatalf("Failed to change directory: %v", err)
+	}
+	defer os.Chdir(wd)
+
+	files := []file.File{{Path: "README.md", Content: "# README\n"}}
+	candidateBlocks := parseCandidateBlocks(files)
+	investigations := []Investigation{
+		{Result: InvestigationResult{TargetFiles: []string{"README.md", "./main.go"}}},
+		{Result: InvestigationResult{TargetFiles: []string{"main.go", "missing.go"}}},
+		{Result: InvestigationResult{TargetFiles: []string{"../secret.txt", filepath.Join(dir, "secret.txt"), filepath.Join(repo, "main.go")}}}, // outside of the repository or absolute
+	}
+
+	files = addTargetFiles(investigations, files, candidateBlocks)
+	assert.Equal(t, []file.File{{Path: "README.md", Content: "# README\n"}, {Path: "main.go", Content: "package main\n\nfunc main() {}\n"}}, files)
+	assert.Len(t, candidateBlocks["README.md"], 1)
+	if assert.Len(t, candidateBlocks["main.go"], 1) {
+		assert.Equal(t, "main", candidateBlocks["main.go"][0].TargetName)
+	}
+	assert.Len(t, candidateBlocks, 2)
+}
@@ -0,0 +1,182 @@
+package planner
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/nakamasato/aicoder/internal/file"
+)
+
+// Language parses the files of a language into blocks, provides the prompt templates to change the blocks
+// and applies the changes to the files.
+// Register a Language with RegisterLanguage to support a new language without changing the planner or the applier.
+type Language interface {
+	// Name returns the name of the language. e.g. go, hcl
+	Name() string
+	// Extensions returns the file extensions of the language including the dot. e.g. .go
+	Extensions() []string
+	// ParseBlocks parses the file into the candidate blocks to change.
+	ParseBlocks(path string) ([]Block, error)
+	// PromptTemplate returns the prompt template to generate the change of the block with the target type.
+	// The template is formatted with the target name, the path and the content of the block
+	// (the content of the file for ActionTypeAdd).
+	// Empty string is returned if the action is not supported for the target type.
+	PromptTemplate(action ActionType, targetType string) string
+	// Validate checks the syntax of the content of the file.
+	Validate(path string, content []byte) error
+	// ValidateContent checks the syntax of the new content of the block generated with the prompt template.
+	ValidateContent(action ActionType, targetType, content string) error
+	// Apply applies the change of a block to the content of the file read from r and returns the changed content.
+	// The changes of the entire files are applied without the language.
+	Apply(r io.Reader, c BlockChange) ([]byte, error)
+}
+
+var (
+	languagesMu sync.RWMutex
+	languages   = map[string]Language{} // extension -> Language
+)
+
+func init() {
+	RegisterLanguage(goLanguage{})
+	RegisterLanguage(hclLanguage{})
+}
+
+// RegisterLanguage registers the language for its extensions.
+// The language registered later wins if the extensions overlap.
+func RegisterLanguage(lang Language) {
+	languagesMu.Lock()
+	defer languagesMu.Unlock()
+	for _, ext := range lang.Extensions() {
+		languages[ext] = lang
+	}
+}
+
+// LanguageFor returns the language of the file. false is returned if no language is registered for the extension.
+func LanguageFor(path string) (Language, bool) {
+	languagesMu.RLock()
+	defer languagesMu.RUnlock()
+	lang, ok := languages[filepath.Ext(path)]
+	return lang, ok
+}
+
+// ValidateFile checks the syntax of the content with the language of the file.
+// Files without a registered language are not validated.
+func ValidateFile(path string, content []byte) error {
+	lang, ok := LanguageFor(path)
+	if !ok {
+		return nil
+	}
+	return lang.Validate(path, content)
+}
+
+type goLanguage struct{}
+
+func (goLanguage) Name() string { return "go" }
+
+func (goLanguage) Extensions() []string { return []string{".go"} }
+
+func (goLanguage) ParseBlocks(path string) ([]Block, error) {
+	blocks, err := file.ParseGoBlocks(path)
+	if err != nil {
+		return nil, err
+	}
+	var blks []Block
+	for _, b := range blocks {
+		blks = append(blks, Block{Path: path, TargetType: b.Type, TargetName: b.Name, Content: b.Content})
+	}
+	return blks, nil
+}
+
+func (goLanguage) PromptTemplate(action ActionType, targetType string) string {
+	switch {
+	case action == ActionTypeAdd:
+		return GENERATE_NEW_BLOCK_PROMPT_GO
+	case targetType == file.GoBlockFunction || targetType == file.GoBlockMethod:
+		return GENERATE_FUNCTION_CHANGES_PLAN_PROMPT_GO // only the body of function
+	default:
+		return GENERATE_DECL_CHANGES_PLAN_PROMPT_GO // entire declaration of type, const/var and import
+	}
+}
+
+func (goLanguage) Validate(path string, content []byte) error {
+	if _, err := parser.ParseFile(token.NewFileSet(), path, content, parser.AllErrors); err != nil {
+		return fmt.Errorf("invalid go file: %w", err)
+	}
+	return nil
+}
+
+func (goLanguage) ValidateContent(action ActionType, targetType, content string) error {
+	if action == ActionTypeUpdate && (targetType == file.GoBlockFunction || targetType == file.GoBlockMethod) {
+		// only the body of the function
+		if _, err := parser.ParseExpr(fmt.Sprintf("func() { %s }", content)); err != nil {
+			return fmt.Errorf("invalid go function body: %w", err)
+		}
+		return nil
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+content, parser.AllErrors)
+	if err != nil && action == ActionTypeUpdate && !strings.HasPrefix(strings.TrimSpace(content), "type") {
+		// type spec in a grouped declaration doesn't have the keyword
+		f, err = parser.ParseFile(token.NewFileSet(), "", "package p\ntype "+content, parser.AllErrors)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid go declaration: %w", err)
+	}
+	if len(f.Decls) == len(f.Imports) && action == ActionTypeAdd {
+		return fmt.Errorf("no go declaration found other than imports")
+	}
+	if len(f.Decls) == 0 {
+		return fmt.Errorf("no go declaration found")
+	}
+	return nil
+}
+
+type hclLanguage struct{}
+
+func (hclLanguage) Name() string { return "hcl" }
+
+func (hclLanguage) Extensions() []string { return []string{".hcl", ".tf"} }
+
+func (hclLanguage) ParseBlocks(path string) ([]Block, error) {
+	blocks, _, err := file.ParseHCL(path)
+	if err != nil {
+		return nil, err
+	}
+	var blks []Block
+	for _, b := range blocks {
+		blks = append(blks, Block{Path: path, TargetType: b.Type, TargetName: strings.Join(b.Labels, ","), Content: b.Content})
+	}
+	return blks, nil
+}
+
+func (hclLanguage) PromptTemplate(action ActionType, targetType string) string {
+	if action == ActionTypeAdd {
+		return GENERATE_NEW_BLOCK_PROMPT_HCL
+	}
+	// TODO: enable to change attr in hcl
+	return GENERATE_BLOCK_CHANGES_PLAN_PROMPT_HCL // Use block as a unit of block for hcl
+}
+
+func (hclLanguage) Validate(path string, content []byte) error {
+	if _, diags := hclwrite.ParseConfig(content, path, hcl.InitialPos); diags.HasErrors() {
+		return fmt.Errorf("invalid hcl file: %s", diags.Error())
+	}
+	return nil
+}
+
+func (hclLanguage) ValidateContent(action ActionType, targetType, content string) error {
+	f, diags := hclwrite.ParseConfig([]byte(content), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return fmt.Errorf("invalid hcl block: %s", diags.Error())
+	}
+	if action == ActionTypeAdd && len(f.Body().Blocks()) == 0 {
+		return fmt.Errorf("no block found in the new content")
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/stretchr/testify/assert"
+)
+
+// yamlLanguage treats each top-level key of a YAML file as a block.
+type yamlLanguage struct{}
+
+func (yamlLanguage) Name() string { return "yaml" }
+
+func (yamlLanguage) Extensions() []string { return []string{".yaml"} }
+
+func (yamlLanguage) ParseBlocks(path string) ([]Block, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var blocks []Block
+	for _, line := range strings.Split(string(content), "\n") {
+		if key, _, ok := strings.Cut(line, ":"); ok && !strings.HasPrefix(line, " ") {
+			blocks = append(blocks, Block{Path: path, TargetType: "key", TargetName: key, Content: line})
+		}
+	}
+	return blocks, nil
+}
+
+func (yamlLanguage) PromptTemplate(action ActionType, targetType string) string {
+	if action == ActionTypeAdd {
+		return "" // not supported
+	}
+	return "Please update the YAML key %s in the file %s\n%s"
+}
+
+func (yamlLanguage) Validate(path string, content []byte) error {
+	if strings.Contains(string(content), "\t") {
+		return fmt.Errorf("tabs are not allowed")
+	}
+	return nil
+}
+
+func (yamlLanguage) ValidateContent(action ActionType, targetType, content string) error {
+	return yamlLanguage{}.Validate("", []byte(content))
+}
+
+// Apply replaces the line of the key.
+func (yamlLanguage) Apply(r io.Reader, c BlockChange) ([]byte, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(src), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, c.Block.TargetName+":") {
+			lines[i] = c.NewContent
+		}
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+func TestLanguageFor(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "main.go", want: "go"},
+		{path: "main.tf", want: "hcl"},
+		{path: "config.hcl", want: "hcl"},
+		{path: "README.md", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			lang, ok := LanguageFor(tt.path)
+			assert.Equal(t, tt.want != "", ok)
+			if ok {
+				assert.Equal(t, tt.want, lang.Name())
+			}
+		})
+	}
+}
+
+func TestRegisterLanguage(t *testing.T) {
+	RegisterLanguage(yamlLanguage{})
+	defer func() {
+		languagesMu.Lock()
+		delete(languages, ".yaml")
+		languagesMu.Unlock()
+	}()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("name: old\nitems:\n  - a\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+
+	candidateBlocks := parseCandidateBlocks([]file.File{{Path: path}, {Path: "README.md", Content: "# README"}})
+	assert.Equal(t, []Block{
+		{Path: path, TargetType: "key", TargetName: "name", Content: "name: old"},
+		{Path: path, TargetType: "key", TargetName: "items", Content: "items:"},
+	}, candidateBlocks[path])
+	assert.Equal(t, []Block{{Path: "README.md", TargetType: "file", TargetName: "README.md", Content: "# README"}}, candidateBlocks["README.md"])
+
+	planner := NewPlanner(llm.DummyClient{ReturnValue: `{"new_content": "name: new", "new_comment": ""}`}, &ent.Client{})
+	got, err := planner.generateBlockChange(context.Background(), "step", TargetBlock{Action: ActionTypeUpdate, Path: path, TargetType: "key", TargetName: "name"}, nil, candidateBlocks, "", nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &BlockChange{Action: ActionTypeUpdate, Block: candidateBlocks[path][0], NewContent: "name: new"}, got)
+
+	// add is not supported by the language
+	got, err = planner.generateBlockChange(context.Background(), "step", TargetBlock{Action: ActionTypeAdd, Path: path, TargetType: "key", TargetName: "new"}, nil, candidateBlocks, "", nil, "")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	assert.Error(t, ValidateFile(path, []byte("name:\tnew")))
+	assert.NoError(t, ValidateFile("README.md", []byte("\t")))
+	assert.Error(t, ValidateFile("main.go", []byte("package main\nfunc {")))
+}
@@ -6,6 +6,7 @@ The plan has two parts:
 2. File change: make file changes plan (change what in which file) to achieve the goal.
 
 The step can be one or more.
+When the change affects the behavior of Go code, please include steps to update the corresponding tests (e.g. *_test.go files) as well.
 
 -----------------------
 Goal: %s
@@ -32,7 +33,13 @@ Files option:
 
 ------------------------
 Please provide the complete set of locations as either a class name, a function name, a struct name, or a variable name.
+For Go files, target_type is one of function, method, struct, interface, type, const, var and import, and the name of a method is qualified with the receiver type (e.g. Client.Search).
+Please also provide the action for each block:
+- update: change the content of an existing block.
+- add: add a new block that doesn't exist yet (e.g. a new function or a new resource) to the file. Set target_type to 'file' to create a new file.
+- delete: delete an existing block. Set target_type to 'file' to delete the file.
 Event if multiple files are provided, not necessarily all files need to be changed. Please only provide the blocks that need to be changed.
+If the step changes a Go function and its test functions are provided (e.g. TestXxx in *_test.go), please also provide the test functions that need to be updated.
 
 
 ### Examples:
@@ -74,7 +81,7 @@ func NewPlanner(llmClient llm.Client, entClient *ent.Client) *Planner {
 
 Output:
 
-{\"path\":\"internal/planner/planner.go\",\"target_type\":\"function\",\"target_name\":\"NewPlanner\"}
+{\"action\":\"update\",\"path\":\"internal/planner/planner.go\",\"target_type\":\"function\",\"target_name\":\"NewPlanner\"}
 
 ------------------------
 `
@@ -94,6 +101,26 @@ fmt.Println("Hello, World!")
 ` + "```" + `
 `
 
+const GENERATE_DECL_CHANGES_PLAN_PROMPT_GO = `Please provide the new content of the Go declaration '%s' in the file '%s'
+## Current content
+
+` + "```" + `
+%s
+` + "```" + `
+
+Rules:
+- Provide the entire declaration including the keyword (e.g. type, const, var, import) but without the comment above it.
+- Keep the declarations that are not related to the change as they are.
+
+Output Example:
+` + "```" + `
+type Config struct {
+	Name    string
+	Timeout time.Duration
+}
+` + "```" + `
+`
+
 const GENERATE_BLOCK_CHANGES_PLAN_PROMPT_HCL = `Please provide the new content of the HCL block %s in the file %s
 
 ## Current content
@@ -140,6 +167,56 @@ Rules:
 - Provide the new content of the entire file.
 `
 
+const GENERATE_NEW_BLOCK_PROMPT_GO = `Please provide the new Go declaration '%s' to be added to the file '%s'
+
+## Current content of the file
+
+` + "```" + `
+%s
+` + "```" + `
+
+Rules:
+- Provide the entire declaration including the comment and the signature (e.g. a function, a method, a type or a var/const block).
+- Do not include the package clause or the existing declarations.
+- If the declaration uses packages that are not imported in the file yet, put their import declaration before the declaration. The imports are merged into the imports of the file.
+
+Output Example:
+` + "```" + `
+// Greet prints a greeting message.
+func Greet(name string) {
+	fmt.Printf("Hello, %%s!\n", name)
+}
+` + "```" + `
+`
+
+const GENERATE_NEW_BLOCK_PROMPT_HCL = `Please provide the new HCL block %s to be added to the file %s
+
+## Current content of the file
+
+` + "```" + `
+%s
+` + "```" + `
+
+Rules:
+- Provide the entire block including the block type and the labels.
+- Do not include the existing blocks.
+
+Output Example:
+` + "```" + `
+resource "google_storage_bucket" "example_bucket" {
+  name     = "example-bucket"
+  location = "US"
+}
+` + "```" + `
+`
+
+const GENERATE_NEW_FILE_PROMPT = `Please provide the content of the new file %[2]s
+%[3]s
+Rules:
+- Provide the entire content of the new file.
+- For a Go file, include the package clause and the imports.
+`
+
 const REPLAN_PROMPT = `You are a helpful assistant that generates detailed action plans based on provided project information.
 The plan you've just made failed the validation.
 Please provide a new plan based on the provided feedback.
@@ -0,0 +1,42 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nakamasato/aicoder/internal/file"
+)
+
+// ReviseBlockChange regenerates the change based on the comment given by the user.
+// The query and the investigation results of the plan are passed to the LLM as the context of the change.
+func (p *Planner) ReviseBlockChange(ctx context.Context, plan *ChangesPlan, change BlockChange, comment string) (*BlockChange, error) {
+	if change.GetAction() == ActionTypeDelete {
+		return nil, fmt.Errorf("delete change cannot be revised")
+	}
+
+	target := TargetBlock{Action: change.GetAction(), Path: change.Block.Path, TargetType: change.Block.TargetType, TargetName: change.Block.TargetName}
+	var files []file.File
+	if content, err := os.ReadFile(change.Block.Path); err == nil {
+		files = append(files, file.File{Path: change.Block.Path, Content: string(content)})
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read file (%s): %w", change.Block.Path, err)
+	}
+
+	candidateBlocks := parseCandidateBlocks(files)
+	if change.Block.TargetType == "file" && len(files) > 0 {
+		// the entire file is a candidate block regardless of the language
+		candidateBlocks[change.Block.Path] = []Block{{Path: change.Block.Path, TargetType: "file", TargetName: change.Block.Path, Content: files[0].Content}}
+	}
+
+	review := fmt.Sprintf("Please revise the change of the %s %s in %s.\nCurrent new content:\n%s\nComment: %s", change.Block.TargetType, change.Block.TargetName, change.Block.Path, change.NewContent, comment)
+	revised, err := p.generateBlockChange(ctx, plan.Query, target, files, candidateBlocks, investigationsString(plan.Investigations), plan, review)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revise the change: %w", err)
+	}
+	if revised == nil {
+		return nil, fmt.Errorf("the target %s %s is not found in %s", change.Block.TargetType, change.Block.TargetName, change.Block.Path)
+	}
+	revised.FileHash = change.FileHash
+	return revised, nil
+}
@@ -0,0 +1,39 @@
+package planner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviseBlockChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "main.go")
+	if err := os.WriteFile(path, []byte("package main\n\nfunc Old() {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	planner := NewPlanner(llm.DummyClient{ReturnValue: `{"new_content": "println(\"revised\")", "new_comment": ""}`}, &ent.Client{})
+	plan := &ChangesPlan{Query: "Print in Old"}
+
+	change := BlockChange{Block: Block{Path: path, TargetType: "function", TargetName: "Old"}, NewContent: "return", FileHash: "hash", Decision: DecisionRejected}
+	got, err := planner.ReviseBlockChange(context.Background(), plan, change, "please print")
+	assert.NoError(t, err)
+	assert.Equal(t, "println(\"revised\")", got.NewContent)
+	assert.Equal(t, ActionTypeUpdate, got.Action)
+	assert.Equal(t, "hash", got.FileHash)
+	assert.Equal(t, Decision(""), got.Decision)
+
+	change = BlockChange{Block: Block{Path: path, TargetType: "file"}, NewContent: "package main\n"}
+	got, err = planner.ReviseBlockChange(context.Background(), plan, change, "please print")
+	assert.NoError(t, err)
+	assert.Equal(t, "println(\"revised\")", got.NewContent)
+
+	_, err = planner.ReviseBlockChange(context.Background(), plan, BlockChange{Block: Block{Path: path, TargetType: "function", TargetName: "Unknown"}}, "")
+	assert.Error(t, err)
+	_, err = planner.ReviseBlockChange(context.Background(), plan, BlockChange{Action: ActionTypeDelete, Block: Block{Path: path, TargetType: "function", TargetName: "Old"}}, "")
+	assert.Error(t, err)
+}
@@ -3,8 +3,11 @@ package planner
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/nakamasato/aicoder/ent"
 	"github.com/nakamasato/aicoder/internal/file"
@@ -235,3 +238,215 @@ resource "google_storage_bucket_iam_member" "member" {
 		t.Errorf("Expected output:\n````\n%s\n````\nGot:\n````\n%s````\n", expectedOutput, output)
 	}
 }
+
+func TestKeepTestCompanions(t *testing.T) {
+	files := []file.File{
+		{Path: "internal/applier/go_applier.go"},
+		{Path: "internal/applier/go_applier_test.go"},
+		{Path: "internal/planner/planner_test.go"},
+		{Path: "README.md"},
+	}
+	filtered := []file.File{{Path: "internal/applier/go_applier.go"}}
+
+	got := keepTestCompanions(files, filtered)
+	assert.Equal(t, []file.File{
+		{Path: "internal/applier/go_applier.go"},
+		{Path: "internal/applier/go_applier_test.go"},
+	}, got)
+}
+
+func TestGenerateBlockChange(t *testing.T) {
+	llmClient := llm.DummyClient{ReturnValue: `{"new_content": "func New() {}", "new_comment": ""}`}
+	planner := NewPlanner(llmClient, &ent.Client{})
+	candidateBlocks := map[string][]Block{
+		"main.go": {
+			{Path: "main.go", TargetType: "function", TargetName: "Old", Content: "func Old() {}"},
+			{Path: "main.go", TargetType: "method", TargetName: "Client.Old", Content: "func (c Client) Old() {}"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		target TargetBlock
+		want   *BlockChange
+	}{
+		{
+			name:   "add function",
+			target: TargetBlock{Action: ActionTypeAdd, Path: "main.go", TargetType: "function", TargetName: "New"},
+			want:   &BlockChange{Action: ActionTypeAdd, Block: Block{Path: "main.go", TargetType: "function", TargetName: "New"}, NewContent: "func New() {}"},
+		},
+		{
+			name:   "delete function",
+			target: TargetBlock{Action: ActionTypeDelete, Path: "main.go", TargetType: "function", TargetName: "Old"},
+			want:   &BlockChange{Action: ActionTypeDelete, Block: candidateBlocks["main.go"][0]},
+		},
+		{
+			name:   "update function",
+			target: TargetBlock{Action: ActionTypeUpdate, Path: "main.go", TargetType: "function", TargetName: "Old"},
+			want:   &BlockChange{Action: ActionTypeUpdate, Block: candidateBlocks["main.go"][0], NewContent: "func New() {}"},
+		},
+		{
+			name:   "update method",
+			target: TargetBlock{Action: ActionTypeUpdate, Path: "main.go", TargetType: "method", TargetName: "Client.Old"},
+			want:   &BlockChange{Action: ActionTypeUpdate, Block: candidateBlocks["main.go"][1], NewContent: "func New() {}"},
+		},
+		{
+			name:   "update unknown function",
+			target: TargetBlock{Action: ActionTypeUpdate, Path: "main.go", TargetType: "function", TargetName: "Unknown"},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planner.generateBlockChange(context.Background(), "step", tt.target, nil, candidateBlocks, "", nil, "")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBlockChange_GetAction(t *testing.T) {
+	assert.Equal(t, ActionTypeUpdate, BlockChange{}.GetAction())
+	assert.Equal(t, ActionTypeDelete, BlockChange{Action: ActionTypeDelete}.GetAction())
+}
+
+func TestMergeStepTargets(t *testing.T) {
+	steps := []string{"step1", "step2"}
+	stepTargets := [][]TargetBlock{
+		{
+			{Action: ActionTypeUpdate, Path: "main.go", TargetType: "function", TargetName: "A"},
+			{Action: ActionTypeAdd, Path: "main.go", TargetType: "function", TargetName: "B"},
+			{Action: ActionTypeUpdate, Path: "README.md", TargetType: "file", TargetName: "README.md"},
+		},
+		{
+			{Action: ActionTypeUpdate, Path: "main.go", TargetType: "function", TargetName: "B"},
+			{Action: ActionTypeUpdate, Path: "main.go", TargetType: "function", TargetName: "C"},
+			{Action: ActionTypeUpdate, Path: "README.md", TargetType: "file", TargetName: "./README.md"},
+		},
+	}
+
+	got := mergeStepTargets(steps, stepTargets)
+	assert.Equal(t, []*stepTarget{
+		{target: stepTargets[0][0], steps: []string{"step1"}},
+		{target: stepTargets[0][1], steps: []string{"step1", "step2"}}, // added in step1 and updated in step2
+		{target: stepTargets[0][2], steps: []string{"step1", "step2"}},
+		{target: stepTargets[1][1], steps: []string{"step2"}},
+	}, got)
+
+	// the block added in step1 and deleted in step2 is dropped
+	stepTargets[1][0].Action = ActionTypeDelete
+	got = mergeStepTargets(steps, stepTargets)
+	assert.Equal(t, []*stepTarget{
+		{target: stepTargets[0][0], steps: []string{"step1"}},
+		{target: stepTargets[0][2], steps: []string{"step1", "step2"}},
+		{target: stepTargets[1][1], steps: []string{"step2"}},
+	}, got)
+}
+
+func TestMergeAction(t *testing.T) {
+	tests := []struct {
+		current, next, want ActionType
+		ok                  bool
+	}{
+		{ActionTypeUpdate, ActionTypeUpdate, ActionTypeUpdate, true},
+		{ActionTypeAdd, ActionTypeUpdate, ActionTypeAdd, true},
+		{ActionTypeUpdate, ActionTypeDelete, ActionTypeDelete, true},
+		{ActionTypeDelete, ActionTypeAdd, ActionTypeUpdate, true},
+		{ActionTypeAdd, ActionTypeDelete, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := mergeAction(tt.current, tt.next)
+		assert.Equal(t, tt.want, got, "%s -> %s", tt.current, tt.next)
+		assert.Equal(t, tt.ok, ok, "%s -> %s", tt.current, tt.next)
+	}
+}
+
+// echoClient returns the prompt as the new content after a delay that is longer for the earlier targets
+// so that the changes complete in the reverse order.
+type echoClient struct {
+	llm.DummyClient
+	mu      sync.Mutex
+	running int
+	maxRun  int
+}
+
+func (c *echoClient) GenerateCompletion(ctx context.Context, messages []llm.Message, schema llm.Schema) (string, error) {
+	c.mu.Lock()
+	c.running++
+	c.maxRun = max(c.maxRun, c.running)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.running--
+		c.mu.Unlock()
+	}()
+
+	_, rest, _ := strings.Cut(messages[len(messages)-1].Content, "function '")
+	name, _, _ := strings.Cut(rest, "'")
+	time.Sleep(time.Duration('z'-name[0]) * time.Millisecond)
+	data, err := json.Marshal(ChangeDiff{NewContent: name})
+	return string(data), err
+}
+
+func TestGenerateBlockChanges(t *testing.T) {
+	client := &echoClient{}
+	planner := NewPlanner(client, &ent.Client{}, WithConcurrency(2))
+	candidateBlocks := map[string][]Block{}
+	var targets []*stepTarget
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		candidateBlocks["main.go"] = append(candidateBlocks["main.go"], Block{Path: "main.go", TargetType: "function", TargetName: name})
+		targets = append(targets, &stepTarget{target: TargetBlock{Action: ActionTypeUpdate, Path: "main.go", TargetType: "function", TargetName: name}, steps: []string{"step"}})
+	}
+	targets = append(targets, &stepTarget{target: TargetBlock{Action: ActionTypeUpdate, Path: "main.go", TargetType: "function", TargetName: "unknown"}, steps: []string{"step"}})
+
+	changes, err := planner.generateBlockChanges(context.Background(), targets, nil, candidateBlocks, "", nil, "")
+	assert.NoError(t, err)
+	var names []string
+	for _, c := range changes {
+		names = append(names, c.Block.TargetName)
+		assert.Equal(t, c.Block.TargetName, c.NewContent)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, names) // unknown block is skipped
+	assert.LessOrEqual(t, client.maxRun, 2)
+}
+
+// recordingClient returns the ReturnValue and records the messages of each call.
+type recordingClient struct {
+	llm.DummyClient
+	mu       sync.Mutex
+	messages [][]llm.Message
+}
+
+func (c *recordingClient) GenerateCompletion(ctx context.Context, messages []llm.Message, schema llm.Schema) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, messages)
+	return c.ReturnValue, nil
+}
+
+func TestConventions(t *testing.T) {
+	hasConventions := func(messages []llm.Message) bool {
+		for _, m := range messages {
+			if m.Role == llm.RoleSystem && strings.Contains(m.Content, "- Wrap errors with %w.") {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, conventions := range []string{"", "- Wrap errors with %w."} {
+		client := &recordingClient{DummyClient: llm.DummyClient{ReturnValue: `{"investigate_steps": [], "change_steps": [], "new_content": "return nil"}`}}
+		planner := NewPlanner(client, &ent.Client{}, WithConventions(conventions))
+
+		_, err := planner.makeActionPlan(context.Background(), nil, "", "Refactor Func1", "")
+		assert.NoError(t, err)
+		_, err = planner.GenerateBlockChangePlan(context.Background(), "%s %s %s", ActionTypeUpdate, "step", Block{Path: "main.go", TargetType: "function", TargetName: "main"}, "func main() {}", "", nil, "")
+		assert.NoError(t, err)
+
+		assert.Len(t, client.messages, 2)
+		for _, messages := range client.messages {
+			assert.Equal(t, conventions != "", hasConventions(messages))
+			assert.Equal(t, llm.RoleUser, messages[len(messages)-1].Role)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeError is a compile error in the new content of a change or the error of a change that cannot be applied.
+type TypeError struct {
+	Index   int    // index of the change in the plan
+	Message string // e.g. internal/planner/planner.go:12:2: undefined: foo
+}
+
+// TypeChecker type-checks the Go changes applied to the current files in memory.
+// The errors are reported for the changes whose new contents contain them and for the changes that cannot be applied.
+type TypeChecker interface {
+	Check(ctx context.Context, changes []BlockChange) ([]TypeError, error)
+}
+
+// repairChanges type-checks the changes of the plan and revises the changes with the compile errors
+// until they compile or maxRepairs attempts are made. The remaining errors are reported without failing the plan.
+func (p *Planner) repairChanges(ctx context.Context, plan *ChangesPlan) error {
+	for attempt := 1; ; attempt++ {
+		typeErrors, err := p.typeChecker.Check(ctx, plan.Changes)
+		if err != nil {
+			// e.g. the go command is not available
+			fmt.Printf("Skip type check: %v\n", err)
+			return nil
+		}
+		if len(typeErrors) == 0 {
+			fmt.Println("No compile errors found.")
+			return nil
+		}
+
+		messages := map[int][]string{}
+		for _, e := range typeErrors {
+			messages[e.Index] = append(messages[e.Index], e.Message)
+		}
+		indices := make([]int, 0, len(messages))
+		for i := range messages {
+			indices = append(indices, i)
+		}
+		sort.Ints(indices)
+
+		if attempt > p.maxRepairs {
+			fmt.Printf("Compile errors remain after %d repair attempts:\n", p.maxRepairs)
+			for _, i := range indices {
+				fmt.Printf("change %d: %s\n", i, strings.Join(messages[i], "\n"))
+			}
+			return nil
+		}
+
+		for _, i := range indices {
+			change := plan.Changes[i]
+			fmt.Printf("Repair change %d (%s %s in %s) attempt %d/%d\n", i, change.Block.TargetType, change.Block.TargetName, change.Block.Path, attempt, p.maxRepairs)
+			comment := fmt.Sprintf("The new content doesn't compile. Please fix the following compile errors without changing the behavior:\n- %s", strings.Join(messages[i], "\n- "))
+			repaired, err := p.ReviseBlockChange(ctx, plan, change, comment)
+			if err != nil {
+				return fmt.Errorf("failed to repair change %d: %w", i, err)
+			}
+			plan.Changes[i] = *repaired
+		}
+	}
+}
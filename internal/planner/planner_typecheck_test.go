@@ -0,0 +1,107 @@
+package planner
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/stretchr/testify/assert"
+)
+
+// undefinedChecker reports the changes whose new contents call undefined.
+type undefinedChecker struct {
+	calls int
+	err   error
+}
+
+func (c *undefinedChecker) Check(ctx context.Context, changes []BlockChange) ([]TypeError, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	var typeErrors []TypeError
+	for i, change := range changes {
+		if change.NewContent == "undefined()" {
+			typeErrors = append(typeErrors, TypeError{Index: i, Message: "main.go:4:2: undefined: undefined"})
+		}
+	}
+	return typeErrors, nil
+}
+
+func TestRepairChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "main.go")
+	if err := os.WriteFile(path, []byte("package main\n\nfunc A() {}\n\nfunc B() {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	newPlan := func() *ChangesPlan {
+		return &ChangesPlan{Query: "Print in A and B", Changes: []BlockChange{
+			{Block: Block{Path: path, TargetType: "function", TargetName: "A"}, NewContent: "println(\"a\")", FileHash: "hash"},
+			{Block: Block{Path: path, TargetType: "function", TargetName: "B"}, NewContent: "undefined()", FileHash: "hash"},
+		}}
+	}
+
+	tests := []struct {
+		name       string
+		client     llm.Client
+		checker    *undefinedChecker
+		maxRepairs int
+		wantB      string
+		wantCalls  int
+	}{
+		{
+			name:       "repaired",
+			client:     &recordingClient{DummyClient: llm.DummyClient{ReturnValue: `{"new_content": "println(\"b\")", "new_comment": ""}`}},
+			checker:    &undefinedChecker{},
+			maxRepairs: 2,
+			wantB:      "println(\"b\")",
+			wantCalls:  2,
+		},
+		{
+			name:       "not repaired within the attempts",
+			client:     &recordingClient{DummyClient: llm.DummyClient{ReturnValue: `{"new_content": "undefined()", "new_comment": ""}`}},
+			checker:    &undefinedChecker{},
+			maxRepairs: 2,
+			wantB:      "undefined()",
+			wantCalls:  3,
+		},
+		{
+			name:      "no repair",
+			client:    &recordingClient{},
+			checker:   &undefinedChecker{},
+			wantB:     "undefined()",
+			wantCalls: 1,
+		},
+		{
+			name:       "checker error is skipped",
+			client:     &recordingClient{},
+			checker:    &undefinedChecker{err: errors.New("go command not found")},
+			maxRepairs: 2,
+			wantB:      "undefined()",
+			wantCalls:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := newPlan()
+			planner := NewPlanner(tt.client, &ent.Client{}, WithTypeCheck(tt.checker, tt.maxRepairs))
+			assert.NoError(t, planner.repairChanges(context.Background(), plan))
+			assert.Equal(t, tt.wantCalls, tt.checker.calls)
+			assert.Equal(t, "println(\"a\")", plan.Changes[0].NewContent)
+			assert.Equal(t, tt.wantB, plan.Changes[1].NewContent)
+			assert.Equal(t, "hash", plan.Changes[1].FileHash)
+		})
+	}
+
+	// the compile errors are passed to the LLM
+	client := &recordingClient{DummyClient: llm.DummyClient{ReturnValue: `{"new_content": "println(\"b\")", "new_comment": ""}`}}
+	planner := NewPlanner(client, &ent.Client{}, WithTypeCheck(&undefinedChecker{}, 1))
+	assert.NoError(t, planner.repairChanges(context.Background(), newPlan()))
+	if assert.Len(t, client.messages, 1) {
+		last := client.messages[0][len(client.messages[0])-1]
+		assert.Contains(t, last.Content, "main.go:4:2: undefined: undefined")
+	}
+}
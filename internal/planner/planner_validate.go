@@ -0,0 +1,196 @@
+package planner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nakamasato/aicoder/internal/file"
+)
+
+type IssueKind string
+
+const (
+	// IssueKindStale means the target of the change doesn't match the current file (e.g. the file or the block no longer exists).
+	IssueKindStale IssueKind = "stale"
+	// IssueKindConflict means the change conflicts with another change in the plan or with the current file.
+	IssueKindConflict IssueKind = "conflict"
+	// IssueKindInvalid means the new content of the change is not valid.
+	IssueKindInvalid IssueKind = "invalid"
+	// IssueKindDrift means the target of the change has been changed since the plan was generated and the changes cannot be merged.
+	IssueKindDrift IssueKind = "drift"
+)
+
+// Issue is a problem of a change in the plan found by ValidatePlan.
+type Issue struct {
+	Index   int // index of the change in the plan
+	Change  BlockChange
+	Kind    IssueKind
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("change %d (%s %s %s in %s): %s: %s", i.Index, i.Change.GetAction(), i.Change.Block.TargetType, i.Change.Block.TargetName, i.Change.Block.Path, i.Kind, i.Message)
+}
+
+// ValidatePlan checks the changes of the plan against the current files without changing them.
+// Each change is resolved against the current file (and the blocks added or deleted by the preceding changes),
+// the new content is parsed with the language of the file, and the changes to the same block are reported as conflicts.
+func ValidatePlan(plan *ChangesPlan) []Issue {
+	var issues []Issue
+	v := &planValidator{
+		blocks:     map[string][]Block{},
+		exists:     map[string]bool{},
+		changed:    map[string]int{},
+		blockFiles: map[string]int{},
+	}
+	for i, c := range plan.Changes {
+		for _, issue := range v.validate(c) {
+			issue.Index = i
+			issue.Change = c
+			issues = append(issues, issue)
+		}
+		v.record(i, c)
+	}
+	return issues
+}
+
+// planValidator keeps the state of the files after the changes validated so far.
+type planValidator struct {
+	blocks     map[string][]Block // path -> current blocks
+	exists     map[string]bool    // block key or file path -> exists after the changes
+	changed    map[string]int     // block key or file path -> index of the change
+	blockFiles map[string]int     // file path -> index of the first change to a block in the file
+}
+
+func blockKey(b Block) string {
+	return strings.Join([]string{b.Path, b.TargetType, b.TargetName}, "\x00")
+}
+
+func (v *planValidator) validate(c BlockChange) []Issue {
+	var issues []Issue
+	issue := func(kind IssueKind, format string, args ...any) {
+		issues = append(issues, Issue{Kind: kind, Message: fmt.Sprintf(format, args...)})
+	}
+	path := c.Block.Path
+	action := c.GetAction()
+
+	switch action {
+	case ActionTypeAdd, ActionTypeUpdate, ActionTypeDelete:
+	default:
+		issue(IssueKindInvalid, "unknown action %q", action)
+		return issues
+	}
+
+	if c.Block.TargetType == "file" {
+		if i, ok := v.changed[path]; ok {
+			issue(IssueKindConflict, "the file is also changed by change %d", i)
+		} else if i, ok := v.blockFiles[path]; ok {
+			issue(IssueKindConflict, "a block in the file is changed by change %d", i)
+		}
+		fileExists := file.Exists(path)
+		if e, ok := v.exists[path]; ok {
+			fileExists = e
+		}
+		if action == ActionTypeAdd && fileExists {
+			issue(IssueKindConflict, "file %s already exists", path)
+		} else if action != ActionTypeAdd && !fileExists {
+			issue(IssueKindStale, "file %s not found", path)
+		} else if err := checkDrift(c); err != nil {
+			issue(IssueKindDrift, "%v", err)
+		}
+		if action != ActionTypeDelete {
+			if err := ValidateFile(path, []byte(c.NewContent)); err != nil {
+				issue(IssueKindInvalid, "%v", err)
+			}
+		}
+		return issues
+	}
+
+	lang, ok := LanguageFor(path)
+	if !ok {
+		issue(IssueKindInvalid, "unsupported file type: %s", path)
+		return issues
+	}
+	if i, ok := v.changed[path]; ok {
+		issue(IssueKindConflict, "the entire file is changed by change %d", i)
+	}
+	key := blockKey(c.Block)
+	if i, ok := v.changed[key]; ok {
+		issue(IssueKindConflict, "the block is also changed by change %d", i)
+	}
+
+	blocks, err := v.currentBlocks(lang, path)
+	if err != nil {
+		issue(IssueKindStale, "%v", err)
+		return issues
+	}
+	blockExists := false
+	if _, ok := findBlock(blocks, TargetBlock{Path: path, TargetType: c.Block.TargetType, TargetName: c.Block.TargetName}); ok {
+		blockExists = true
+	}
+	if e, ok := v.exists[key]; ok {
+		blockExists = e
+	}
+	if action == ActionTypeAdd && blockExists {
+		issue(IssueKindConflict, "%s %s already exists in %s", c.Block.TargetType, c.Block.TargetName, path)
+	} else if action != ActionTypeAdd && !blockExists {
+		issue(IssueKindStale, "%s %s not found in %s", c.Block.TargetType, c.Block.TargetName, path)
+	} else if err := checkDrift(c); err != nil {
+		issue(IssueKindDrift, "%v", err)
+	}
+
+	if action == ActionTypeUpdate && c.NewContent == "" && c.NewComment == "" {
+		issue(IssueKindInvalid, "neither new content nor new comment is specified")
+	} else if action != ActionTypeDelete && (action == ActionTypeAdd || c.NewContent != "") {
+		if err := lang.ValidateContent(action, c.Block.TargetType, c.NewContent); err != nil {
+			issue(IssueKindInvalid, "%v", err)
+		}
+	}
+	return issues
+}
+
+// checkDrift returns an error if the target has been changed since the plan was generated
+// and the change cannot be applied safely (see DetectDrift and MergeDrift).
+func checkDrift(c BlockChange) error {
+	drift, err := DetectDrift(c)
+	if err != nil || drift == nil || !drift.BlockChanged {
+		return err
+	}
+	if _, conflict, err := MergeDrift(c, drift); err != nil {
+		return err
+	} else if conflict {
+		return fmt.Errorf("%s", drift)
+	}
+	return nil
+}
+
+// currentBlocks parses the current file once with the language.
+func (v *planValidator) currentBlocks(lang Language, path string) ([]Block, error) {
+	if blocks, ok := v.blocks[path]; ok {
+		return blocks, nil
+	}
+	if !file.Exists(path) {
+		return nil, fmt.Errorf("file %s not found", path)
+	}
+	blocks, err := lang.ParseBlocks(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	v.blocks[path] = blocks
+	return blocks, nil
+}
+
+// record records the state after the change so that the following changes are validated against it.
+func (v *planValidator) record(i int, c BlockChange) {
+	if c.Block.TargetType == "file" {
+		v.changed[c.Block.Path] = i
+		v.exists[c.Block.Path] = c.GetAction() != ActionTypeDelete
+		return
+	}
+	if _, ok := v.blockFiles[c.Block.Path]; !ok {
+		v.blockFiles[c.Block.Path] = i
+	}
+	key := blockKey(c.Block)
+	v.changed[key] = i
+	v.exists[key] = c.GetAction() != ActionTypeDelete
+}
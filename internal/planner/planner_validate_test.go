@@ -0,0 +1,98 @@
+package planner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePlan(t *testing.T) {
+	tempDir := t.TempDir()
+	goFile := filepath.Join(tempDir, "main.go")
+	hclFile := filepath.Join(tempDir, "main.tf")
+	mdFile := filepath.Join(tempDir, "README.md")
+	files := map[string]string{
+		goFile: `package main
+
+type Client struct{}
+
+func (c *Client) Search() {}
+
+func Old() {}
+`,
+		hclFile: `resource "google_storage_bucket" "example" {
+  name = "example"
+}
+`,
+		mdFile: "# README\n",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		changes []BlockChange
+		want    []string // kind of the issue for each change with issues
+	}{
+		{
+			name: "valid",
+			changes: []BlockChange{
+				{Block: Block{Path: goFile, TargetType: "method", TargetName: "Client.Search"}, NewContent: "return"},
+				{Block: Block{Path: goFile, TargetType: "struct", TargetName: "Client"}, NewContent: "type Client struct{ name string }"},
+				{Action: ActionTypeAdd, Block: Block{Path: goFile, TargetType: "function", TargetName: "New"}, NewContent: "func New() {}"},
+				{Action: ActionTypeDelete, Block: Block{Path: goFile, TargetType: "function", TargetName: "Old"}},
+				{Block: Block{Path: hclFile, TargetType: "resource", TargetName: "google_storage_bucket,example"}, NewContent: "name = \"new\""},
+				{Action: ActionTypeAdd, Block: Block{Path: filepath.Join(tempDir, "new.go"), TargetType: "file"}, NewContent: "package main\n"},
+			},
+		},
+		{
+			name: "stale",
+			changes: []BlockChange{
+				{Block: Block{Path: goFile, TargetType: "function", TargetName: "Unknown"}, NewContent: "return"},
+				{Block: Block{Path: filepath.Join(tempDir, "unknown.go"), TargetType: "function", TargetName: "main"}, NewContent: "return"},
+				{Action: ActionTypeDelete, Block: Block{Path: filepath.Join(tempDir, "unknown.md"), TargetType: "file"}},
+			},
+			want: []string{"0 stale", "1 stale", "2 stale"},
+		},
+		{
+			name: "invalid",
+			changes: []BlockChange{
+				{Block: Block{Path: goFile, TargetType: "method", TargetName: "Client.Search"}, NewContent: "if {"},
+				{Action: ActionTypeAdd, Block: Block{Path: goFile, TargetType: "function", TargetName: "New"}, NewContent: "import \"fmt\""},
+				{Block: Block{Path: hclFile, TargetType: "resource", TargetName: "google_storage_bucket,example"}, NewContent: "name = "},
+				{Block: Block{Path: mdFile, TargetType: "file"}},
+				{Block: Block{Path: filepath.Join(tempDir, "main.py"), TargetType: "function", TargetName: "main"}, NewContent: "pass"},
+			},
+			want: []string{"0 invalid", "1 invalid", "2 invalid", "4 invalid"},
+		},
+		{
+			name: "conflict",
+			changes: []BlockChange{
+				{Block: Block{Path: goFile, TargetType: "function", TargetName: "Old"}, NewContent: "return"},
+				{Block: Block{Path: goFile, TargetType: "function", TargetName: "Old"}, NewContent: "return"},
+				{Action: ActionTypeAdd, Block: Block{Path: goFile, TargetType: "struct", TargetName: "Client"}, NewContent: "type Client struct{}"},
+				{Block: Block{Path: goFile, TargetType: "file"}, NewContent: "package main\n"},
+				{Action: ActionTypeAdd, Block: Block{Path: mdFile, TargetType: "file"}, NewContent: "# README\n"},
+			},
+			want: []string{"1 conflict", "2 conflict", "3 conflict", "4 conflict"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, issue := range ValidatePlan(&ChangesPlan{Changes: tt.changes}) {
+				t.Log(issue)
+				if kind := fmt.Sprintf("%d %s", issue.Index, issue.Kind); len(got) == 0 || got[len(got)-1] != kind {
+					got = append(got, kind)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package prompt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// Spec is a prompt template that can be overridden by <dir>/<Name> in the prompts directory.
+type Spec struct {
+	Name    string           // file name in the prompts directory. e.g. locator_file.tmpl
+	Default string           // template compiled into the binary
+	Data    any              // sample of the data passed to the template to validate the overrides
+	Funcs   template.FuncMap // functions available in the template
+}
+
+// ExampleSpec is a set of few-shot examples that can be overridden by <dir>/examples/<Name> in the prompts directory.
+type ExampleSpec struct {
+	Name    string // file name in the examples directory. e.g. action_plan.json
+	Default any    // examples compiled into the binary. The overrides are decoded into the same type.
+}
+
+var (
+	specsMu      sync.RWMutex
+	specs        = map[string]Spec{}
+	exampleSpecs = map[string]ExampleSpec{}
+)
+
+// RegisterTemplate registers the template that can be overridden.
+func RegisterTemplate(spec Spec) {
+	specsMu.Lock()
+	defer specsMu.Unlock()
+	specs[spec.Name] = spec
+}
+
+// RegisterExamples registers the examples that can be overridden.
+func RegisterExamples(spec ExampleSpec) {
+	specsMu.Lock()
+	defer specsMu.Unlock()
+	exampleSpecs[spec.Name] = spec
+}
+
+// Loader loads the prompt templates and the examples from the prompts directory (prompts.dir in .aicoder.yaml).
+// The defaults are used for the files that don't exist in the directory or when the directory is not configured.
+//
+//	<dir>/<name>.tmpl           overrides the template (e.g. locator_file.tmpl)
+//	<dir>/examples/<name>.json  overrides the examples (e.g. action_plan.json)
+type Loader struct {
+	dir string
+}
+
+// NewLoader creates a Loader for the directory. The defaults are always used if dir is empty.
+func NewLoader(dir string) *Loader {
+	return &Loader{dir: dir}
+}
+
+// Template returns the template with the name. The override is validated by executing it with the sample data.
+func (l *Loader) Template(name string) (string, error) {
+	specsMu.RLock()
+	spec, ok := specs[name]
+	specsMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("unknown template: %s", name)
+	}
+	if l == nil || l.dir == "" {
+		return spec.Default, nil
+	}
+	data, err := os.ReadFile(filepath.Join(l.dir, name))
+	if os.IsNotExist(err) {
+		return spec.Default, nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to read template (%s): %w", name, err)
+	}
+	if err := validateTemplate(spec, string(data)); err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Examples returns the examples with the name. T must be the type of the default examples.
+// Unknown fields in the override are rejected to catch typos.
+func Examples[T any](l *Loader, name string) (T, error) {
+	var zero T
+	specsMu.RLock()
+	spec, ok := exampleSpecs[name]
+	specsMu.RUnlock()
+	if !ok {
+		return zero, fmt.Errorf("unknown examples: %s", name)
+	}
+	defaults, ok := spec.Default.(T)
+	if !ok {
+		return zero, fmt.Errorf("examples %s is %T, not %T", name, spec.Default, zero)
+	}
+	if l == nil || l.dir == "" {
+		return defaults, nil
+	}
+	data, err := os.ReadFile(filepath.Join(l.dir, "examples", name))
+	if os.IsNotExist(err) {
+		return defaults, nil
+	} else if err != nil {
+		return zero, fmt.Errorf("failed to read examples (%s): %w", name, err)
+	}
+	var examples T
+	if err := decodeExamples(name, data, &examples); err != nil {
+		return zero, err
+	}
+	return examples, nil
+}
+
+// Validate validates all the files in the prompts directory.
+// Files that don't correspond to any registered template or examples are reported as errors.
+func (l *Loader) Validate() error {
+	if l == nil || l.dir == "" {
+		return nil
+	}
+	specsMu.RLock()
+	defer specsMu.RUnlock()
+
+	var errs []error
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read prompts directory (%s): %w", l.dir, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		spec, ok := specs[entry.Name()]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown template %s (available: %s)", entry.Name(), strings.Join(sortedKeys(specs), ", ")))
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(l.dir, entry.Name()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read template (%s): %w", entry.Name(), err))
+			continue
+		}
+		if err := validateTemplate(spec, string(data)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	entries, err = os.ReadDir(filepath.Join(l.dir, "examples"))
+	if err != nil && !os.IsNotExist(err) {
+		errs = append(errs, fmt.Errorf("failed to read examples directory: %w", err))
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		spec, ok := exampleSpecs[entry.Name()]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown examples %s (available: %s)", entry.Name(), strings.Join(sortedKeys(exampleSpecs), ", ")))
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(l.dir, "examples", entry.Name()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read examples (%s): %w", entry.Name(), err))
+			continue
+		}
+		v := reflect.New(reflect.TypeOf(spec.Default))
+		if err := decodeExamples(entry.Name(), data, v.Interface()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateTemplate parses the template and executes it with the sample data of the spec.
+func validateTemplate(spec Spec, text string) error {
+	tmpl, err := template.New(spec.Name).Funcs(spec.Funcs).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template %s: %w", spec.Name, err)
+	}
+	if err := tmpl.Execute(io.Discard, spec.Data); err != nil {
+		return fmt.Errorf("invalid template %s for the data %T: %w", spec.Name, spec.Data, err)
+	}
+	return nil
+}
+
+func decodeExamples(name string, data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid examples %s: %w", name, err)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
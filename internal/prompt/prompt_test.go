@@ -0,0 +1,81 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testData struct {
+	Query string
+	Files []string
+}
+
+type testExample struct {
+	Goal  string   `json:"goal"`
+	Steps []string `json:"steps"`
+}
+
+func TestLoader(t *testing.T) {
+	RegisterTemplate(Spec{Name: "test.tmpl", Default: "default {{.Query}}", Data: testData{Files: []string{""}}})
+	RegisterExamples(ExampleSpec{Name: "test.json", Default: []testExample{{Goal: "default"}}})
+	defer func() {
+		specsMu.Lock()
+		delete(specs, "test.tmpl")
+		delete(exampleSpecs, "test.json")
+		specsMu.Unlock()
+	}()
+
+	// defaults
+	for _, l := range []*Loader{nil, NewLoader(""), NewLoader(t.TempDir())} {
+		got, err := l.Template("test.tmpl")
+		assert.NoError(t, err)
+		assert.Equal(t, "default {{.Query}}", got)
+		examples, err := Examples[[]testExample](l, "test.json")
+		assert.NoError(t, err)
+		assert.Equal(t, []testExample{{Goal: "default"}}, examples)
+		assert.NoError(t, l.Validate())
+	}
+	_, err := NewLoader("").Template("unknown.tmpl")
+	assert.Error(t, err)
+	_, err = Examples[[]string](NewLoader(""), "test.json")
+	assert.Error(t, err)
+
+	// overrides
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "test.tmpl"), "custom {{range .Files}}{{.}}{{end}}")
+	writeFile(t, filepath.Join(dir, "examples", "test.json"), `[{"goal": "custom", "steps": ["a"]}]`)
+	l := NewLoader(dir)
+	got, err := l.Template("test.tmpl")
+	assert.NoError(t, err)
+	assert.Equal(t, "custom {{range .Files}}{{.}}{{end}}", got)
+	examples, err := Examples[[]testExample](l, "test.json")
+	assert.NoError(t, err)
+	assert.Equal(t, []testExample{{Goal: "custom", Steps: []string{"a"}}}, examples)
+	assert.NoError(t, l.Validate())
+
+	// invalid overrides
+	writeFile(t, filepath.Join(dir, "test.tmpl"), "{{range .Files}}{{.Name}}{{end}}")
+	writeFile(t, filepath.Join(dir, "examples", "test.json"), `[{"goal": "custom", "step": ["a"]}]`)
+	writeFile(t, filepath.Join(dir, "unknown.tmpl"), "")
+	_, err = l.Template("test.tmpl")
+	assert.ErrorContains(t, err, "invalid template test.tmpl")
+	_, err = Examples[[]testExample](l, "test.json")
+	assert.ErrorContains(t, err, "invalid examples test.json")
+	err = l.Validate()
+	assert.ErrorContains(t, err, "invalid template test.tmpl")
+	assert.ErrorContains(t, err, "invalid examples test.json")
+	assert.ErrorContains(t, err, "unknown template unknown.tmpl")
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+}
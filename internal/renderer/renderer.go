@@ -0,0 +1,247 @@
+package renderer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/nakamasato/aicoder/internal/applier"
+	"github.com/nakamasato/aicoder/internal/planner"
+)
+
+type Format string // implement pflag.Value
+
+const (
+	FormatTerminal Format = "terminal"
+	FormatMarkdown Format = "markdown"
+)
+
+// Set sets the value of the Format.
+func (f *Format) Set(value string) error {
+	switch value {
+	case string(FormatTerminal), string(FormatMarkdown):
+		*f = Format(value)
+		return nil
+	default:
+		return fmt.Errorf("invalid format: %s", value)
+	}
+}
+
+// Type returns the type of the flag as a string.
+func (f *Format) Type() string {
+	return "format"
+}
+
+// String returns the string representation of the Format.
+func (f *Format) String() string {
+	return string(*f)
+}
+
+// RenderPlan writes the query, the steps, the investigation results and the diff of each change of the plan.
+// The diffs are generated against the current files changed by the preceding changes as the changes are applied in order.
+// The new content is shown instead if the change cannot be applied. The first write error is returned.
+func RenderPlan(w io.Writer, plan *planner.ChangesPlan, format Format) error {
+	ew := &errWriter{w: w}
+	var r renderer = &terminalRenderer{w: ew}
+	if format == FormatMarkdown {
+		r = &markdownRenderer{w: ew}
+	}
+
+	r.title(plan.Query, plan.Id)
+	if plan.ActionPlan != nil {
+		r.section("Steps")
+		r.list("Investigation steps", plan.ActionPlan.InvestigateSteps)
+		r.list("Change steps", plan.ActionPlan.ChangeSteps)
+	}
+	if len(plan.Investigations) > 0 {
+		r.section("Investigation results")
+		for i, inv := range plan.Investigations {
+			r.investigation(i+1, inv)
+		}
+	}
+	r.section(fmt.Sprintf("Changes (%d)", len(plan.Changes)))
+	diffs, errs := changeDiffs(plan.Changes)
+	for i, change := range plan.Changes {
+		r.change(i+1, change)
+		if err, ok := errs[i]; ok {
+			r.content(fmt.Sprintf("Cannot show the diff: %v", err), change.NewContent)
+			continue
+		}
+		r.diff(diffs[i])
+	}
+	return ew.err
+}
+
+// changeDiffs returns the unified diff of each change by the index of the change.
+// The rejected changes are not applied as ApplyChanges skips them.
+func changeDiffs(changes []planner.BlockChange) (map[int]string, map[int]error) {
+	diffs := map[int]string{}
+	errs := map[int]error{}
+	var targets []planner.BlockChange
+	var indices []int
+	for i, change := range changes {
+		if change.Decision == planner.DecisionRejected {
+			errs[i] = errors.New("the change is rejected")
+			continue
+		}
+		targets = append(targets, change)
+		indices = append(indices, i)
+	}
+	applier.PreviewEach(targets, func(i int, before, after []byte, err error) {
+		if err == nil {
+			diffs[indices[i]], err = applier.GenerateDiff(targets[i].Block.Path, before, after)
+		}
+		if err != nil {
+			errs[indices[i]] = err
+		}
+	})
+	return diffs, errs
+}
+
+// errWriter keeps the first write error so that the renderers don't need to check each write.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *errWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n, err := w.w.Write(p)
+	w.err = err
+	return n, err
+}
+
+func changeTitle(change planner.BlockChange) string {
+	title := fmt.Sprintf("%s %s %s in %s", change.GetAction(), change.Block.TargetType, change.Block.TargetName, change.Block.Path)
+	if change.Block.TargetType == "file" {
+		title = fmt.Sprintf("%s file %s", change.GetAction(), change.Block.Path)
+	}
+	if change.Decision != "" {
+		title += fmt.Sprintf(" (%s)", change.Decision)
+	}
+	return title
+}
+
+type renderer interface {
+	title(query, id string)
+	section(name string)
+	list(name string, items []string)
+	investigation(n int, inv planner.Investigation)
+	change(n int, change planner.BlockChange)
+	diff(diff string)
+	content(note, content string)
+}
+
+type terminalRenderer struct {
+	w io.Writer
+}
+
+func (r *terminalRenderer) title(query, id string) {
+	color.New(color.Bold).Fprintf(r.w, "Goal: %s\n", query)
+	fmt.Fprintf(r.w, "Plan ID: %s\n", id)
+}
+
+func (r *terminalRenderer) section(name string) {
+	color.New(color.Bold, color.Underline).Fprintf(r.w, "\n%s\n", name)
+}
+
+func (r *terminalRenderer) list(name string, items []string) {
+	fmt.Fprintf(r.w, "%s:\n", name)
+	for i, item := range items {
+		fmt.Fprintf(r.w, "  %d. %s\n", i+1, item)
+	}
+}
+
+func (r *terminalRenderer) investigation(n int, inv planner.Investigation) {
+	color.New(color.Bold).Fprintf(r.w, "%d. %s\n", n, inv.Step)
+	fmt.Fprintf(r.w, "Target files: %s\n", strings.Join(inv.Result.TargetFiles, ", "))
+	fmt.Fprintf(r.w, "Reference files: %s\n", strings.Join(inv.Result.ReferenceFiles, ", "))
+	fmt.Fprintf(r.w, "%s\n", inv.Result.Result)
+}
+
+func (r *terminalRenderer) change(n int, change planner.BlockChange) {
+	color.New(color.Bold).Fprintf(r.w, "\n%d. %s\n", n, changeTitle(change))
+	if change.NewComment != "" {
+		fmt.Fprintf(r.w, "New comment: %s\n", change.NewComment)
+	}
+}
+
+func (r *terminalRenderer) diff(diff string) {
+	for _, line := range strings.Split(strings.TrimRight(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			color.New(color.Bold).Fprintln(r.w, line)
+		case strings.HasPrefix(line, "+"):
+			color.New(color.FgGreen).Fprintln(r.w, line)
+		case strings.HasPrefix(line, "-"):
+			color.New(color.FgRed).Fprintln(r.w, line)
+		case strings.HasPrefix(line, "@@"):
+			color.New(color.FgCyan).Fprintln(r.w, line)
+		default:
+			fmt.Fprintln(r.w, line)
+		}
+	}
+}
+
+func (r *terminalRenderer) content(note, content string) {
+	color.New(color.FgYellow).Fprintln(r.w, note)
+	fmt.Fprintln(r.w, content)
+}
+
+// markdownRenderer renders the plan in Markdown that can be pasted into a pull request description.
+type markdownRenderer struct {
+	w io.Writer
+}
+
+func (r *markdownRenderer) title(query, id string) {
+	fmt.Fprintf(r.w, "## %s\n\nPlan ID: `%s`\n", query, id)
+}
+
+func (r *markdownRenderer) section(name string) {
+	fmt.Fprintf(r.w, "\n### %s\n\n", name)
+}
+
+func (r *markdownRenderer) list(name string, items []string) {
+	fmt.Fprintf(r.w, "**%s**\n\n", name)
+	for i, item := range items {
+		fmt.Fprintf(r.w, "%d. %s\n", i+1, item)
+	}
+	fmt.Fprintln(r.w)
+}
+
+func (r *markdownRenderer) investigation(n int, inv planner.Investigation) {
+	fmt.Fprintf(r.w, "<details><summary>%d. %s</summary>\n\n", n, inv.Step)
+	fmt.Fprintf(r.w, "- Target files: %s\n", codeList(inv.Result.TargetFiles))
+	fmt.Fprintf(r.w, "- Reference files: %s\n\n", codeList(inv.Result.ReferenceFiles))
+	fmt.Fprintf(r.w, "%s\n\n</details>\n\n", inv.Result.Result)
+}
+
+func (r *markdownRenderer) change(n int, change planner.BlockChange) {
+	fmt.Fprintf(r.w, "#### %d. %s\n\n", n, changeTitle(change))
+	if change.NewComment != "" {
+		fmt.Fprintf(r.w, "New comment: %s\n\n", change.NewComment)
+	}
+}
+
+func (r *markdownRenderer) diff(diff string) {
+	fmt.Fprintf(r.w, "```diff\n%s\n```\n\n", strings.TrimRight(diff, "\n"))
+}
+
+func (r *markdownRenderer) content(note, content string) {
+	fmt.Fprintf(r.w, "> %s\n\n```\n%s\n```\n\n", note, strings.TrimRight(content, "\n"))
+}
+
+func codeList(items []string) string {
+	if len(items) == 0 {
+		return "-"
+	}
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = "`" + item + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
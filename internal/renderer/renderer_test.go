@@ -0,0 +1,79 @@
+package renderer
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nakamasato/aicoder/internal/planner"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderPlan_Markdown(t *testing.T) {
+	tempDir := t.TempDir()
+	goFile := filepath.Join(tempDir, "main.go")
+	if err := os.WriteFile(goFile, []byte("package main\n\nfunc Old() {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	newFile := filepath.Join(tempDir, "README.md")
+
+	plan := &planner.ChangesPlan{
+		Id:    "plan-1",
+		Query: "Add New function",
+		ActionPlan: &planner.ActionPlan{
+			InvestigateSteps: []string{"Check main.go"},
+			ChangeSteps:      []string{"Add New", "Add README"},
+		},
+		Investigations: []planner.Investigation{
+			{Step: "Check main.go", Result: planner.InvestigationResult{TargetFiles: []string{"main.go"}, Result: "Old exists"}},
+		},
+		Changes: []planner.BlockChange{
+			{Action: planner.ActionTypeAdd, Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "New"}, NewContent: "func New() {}"},
+			{Action: planner.ActionTypeAdd, Block: planner.Block{Path: newFile, TargetType: "file", TargetName: newFile}, NewContent: "# README\n"},
+			{Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Unknown"}, NewContent: "return"},
+			{Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "New"}, NewContent: "println()"},
+			{Block: planner.Block{Path: goFile, TargetType: "function", TargetName: "Old"}, NewContent: "println()", Decision: planner.DecisionRejected},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, RenderPlan(&buf, plan, FormatMarkdown))
+	assert.Equal(t, "## Add New function\n\nPlan ID: `plan-1`\n"+
+		"\n### Steps\n\n**Investigation steps**\n\n1. Check main.go\n\n**Change steps**\n\n1. Add New\n2. Add README\n\n"+
+		"\n### Investigation results\n\n<details><summary>1. Check main.go</summary>\n\n- Target files: `main.go`\n- Reference files: -\n\nOld exists\n\n</details>\n\n"+
+		"\n### Changes (5)\n\n"+
+		"#### 1. add function New in "+goFile+"\n\n```diff\n--- a"+goFile+"\n+++ b"+goFile+"\n@@ -1,3 +1,5 @@\n package main\n \n func Old() {}\n+\n+func New() {}\n```\n\n"+
+		"#### 2. add file "+newFile+"\n\n```diff\n--- /dev/null\n+++ b"+newFile+"\n@@ -0,0 +1 @@\n+# README\n```\n\n"+
+		"#### 3. update function Unknown in "+goFile+"\n\n> Cannot show the diff: failed to apply change to go file ("+goFile+"): function Unknown not found\n\n```\nreturn\n```\n\n"+
+		"#### 4. update function New in "+goFile+"\n\n```diff\n--- a"+goFile+"\n+++ b"+goFile+"\n@@ -2,4 +2,6 @@\n \n func Old() {}\n \n-func New() {}\n+func New() {\n+\tprintln()\n+}\n```\n\n"+ // applied to the function added by change 1
+		"#### 5. update function Old in "+goFile+" (rejected)\n\n> Cannot show the diff: the change is rejected\n\n```\nprintln()\n```\n\n",
+		buf.String())
+}
+
+// failingWriter fails after writing n bytes.
+type failingWriter struct {
+	n int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		return 0, errors.New("disk full")
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestRenderPlan_WriteError(t *testing.T) {
+	plan := &planner.ChangesPlan{Id: "plan-1", Query: "Add New function"}
+	assert.EqualError(t, RenderPlan(&failingWriter{n: 10}, plan, FormatMarkdown), "disk full")
+	assert.NoError(t, RenderPlan(&failingWriter{n: 1000}, plan, FormatTerminal))
+}
+
+func TestFormat_Set(t *testing.T) {
+	var f Format
+	assert.NoError(t, f.Set("markdown"))
+	assert.Equal(t, FormatMarkdown, f)
+	assert.Error(t, f.Set("html"))
+}
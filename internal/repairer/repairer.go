@@ -12,6 +12,7 @@ import (
 	"github.com/nakamasato/aicoder/internal/file"
 	"github.com/nakamasato/aicoder/internal/llm"
 	"github.com/nakamasato/aicoder/internal/locator"
+	"github.com/nakamasato/aicoder/internal/prompt"
 )
 
 //go:embed templates/repair.tmpl
@@ -22,9 +23,28 @@ var promptExtractBlockContentTemplate string
 
 const RepairInstruction = "Below are some code segments, each from a relevant file. One or more of these files may contain bugs."
 
+// repairData is the data passed to the repair template.
+type repairData struct {
+	Query                         string
+	RepairRelevantFileInstruction string
+	Content                       string
+}
+
+// extractBlockContentData is the data passed to the template to extract the block content.
+type extractBlockContentData struct {
+	Block   llm.Block
+	Content string
+}
+
+func init() {
+	prompt.RegisterTemplate(prompt.Spec{Name: "repair.tmpl", Default: promptRepairTemplate, Data: repairData{}})
+	prompt.RegisterTemplate(prompt.Spec{Name: "extract_block_content.tmpl", Default: promptExtractBlockContentTemplate, Data: extractBlockContentData{}})
+}
+
 type Repairer struct {
 	config    *config.AICoderConfig
 	llmClient llm.Client
+	prompts   *prompt.Loader
 }
 
 type RepairSample struct {
@@ -52,6 +72,7 @@ func NewRepairer(llmClient llm.Client, config *config.AICoderConfig) *Repairer {
 	return &Repairer{
 		config:    config,
 		llmClient: llmClient,
+		prompts:   prompt.NewLoader(config.Prompts.Dir),
 	}
 }
 
@@ -81,10 +102,15 @@ func (r Repairer) repairOneFile(ctx context.Context, query string, blkList llm.B
 		File: blkList.Path,
 	}
 
+	templatefile, err := r.prompts.Template("extract_block_content.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template: %v", err)
+	}
+
 	// get block content
 	for i, blk := range blkList.Blocks {
 		fmt.Printf("[repairOneBlockList][%d/%d] block Path:%s, Type:%s, Name:%s\n", i+1, len(blkList.Blocks), blkList.Path, blk.BlockType, blk.Name)
-		getBlockContentPrompt, err := makeGetBlockContentPrompt(promptExtractBlockContentTemplate, blk, content)
+		getBlockContentPrompt, err := makeGetBlockContentPrompt(templatefile, blk, content)
 		if err != nil {
 			return nil, fmt.Errorf("failed to make prompt %v", err)
 		}
@@ -109,7 +135,11 @@ func (r Repairer) repairOneBlock(ctx context.Context, query string, block llm.Bl
 		Block:        block,
 		BlockContent: content,
 	}
-	prompt, err := makePrompt(promptRepairTemplate, query, content)
+	templatefile, err := r.prompts.Template("repair.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template: %v", err)
+	}
+	prompt, err := makePrompt(templatefile, query, content)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make prompt %v", err)
 	}
@@ -133,11 +163,7 @@ func (r Repairer) repairOneBlock(ctx context.Context, query string, block llm.Bl
 func makePrompt(templatefile, query, content string) (string, error) {
 
 	var prompt string
-	tmplData := struct {
-		Query                         string
-		RepairRelevantFileInstruction string
-		Content                       string
-	}{
+	tmplData := repairData{
 		Query:                         query,
 		RepairRelevantFileInstruction: RepairInstruction,
 		Content:                       content,
@@ -159,10 +185,7 @@ func makePrompt(templatefile, query, content string) (string, error) {
 
 // makeGetBlockContentPrompt generates a prompt for extracting block content
 func makeGetBlockContentPrompt(templatefile string, block llm.Block, content string) (string, error) {
-	tmplData := struct {
-		Block   llm.Block
-		Content string
-	}{
+	tmplData := extractBlockContentData{
 		Block:   block,
 		Content: content,
 	}
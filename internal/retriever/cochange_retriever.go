@@ -0,0 +1,151 @@
+package retriever
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/git"
+	"github.com/nakamasato/aicoder/internal/loader"
+)
+
+const (
+	defaultCoChangeSeeds      = 5
+	defaultCoChangeNeighbours = 5
+	defaultCoChangeMinCount   = 2
+)
+
+// CoChangeRetriever boosts the files that are frequently changed together in the git history
+// with the files found by the base retriever (e.g. cmd/plan/cmd.go and internal/planner/planner.go).
+type CoChangeRetriever struct {
+	base      Retriever
+	history   *git.CoChange
+	reader    file.FileReader
+	structure *loader.RepoStructure
+
+	seeds         int
+	maxNeighbours int
+	minCount      int
+}
+
+type CoChangeRetrieverOption func(*CoChangeRetriever)
+
+// WithCoChangeSeeds sets the number of top results of the base retriever whose neighbours are looked up.
+func WithCoChangeSeeds(n int) CoChangeRetrieverOption {
+	return func(c *CoChangeRetriever) {
+		c.seeds = n
+	}
+}
+
+// WithMaxCoChangeNeighbours sets the maximum number of files added by the co-change history.
+func WithMaxCoChangeNeighbours(n int) CoChangeRetrieverOption {
+	return func(c *CoChangeRetriever) {
+		c.maxNeighbours = n
+	}
+}
+
+// WithCoChangeMinCount sets the minimum number of commits in which two files are changed together to be neighbours.
+func WithCoChangeMinCount(n int) CoChangeRetrieverOption {
+	return func(c *CoChangeRetriever) {
+		c.minCount = n
+	}
+}
+
+// NewCoChangeRetriever creates a retriever that adds the co-changed neighbours to the results of the base retriever.
+// Only the files in the repository structure are added.
+func NewCoChangeRetriever(base Retriever, history *git.CoChange, reader file.FileReader, structure *loader.RepoStructure, opts ...CoChangeRetrieverOption) *CoChangeRetriever {
+	c := &CoChangeRetriever{
+		base:          base,
+		history:       history,
+		reader:        reader,
+		structure:     structure,
+		seeds:         defaultCoChangeSeeds,
+		maxNeighbours: defaultCoChangeNeighbours,
+		minCount:      defaultCoChangeMinCount,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c CoChangeRetriever) Name() string {
+	return "cochange"
+}
+
+// Retrieve retrieves the files with the base retriever and boosts their neighbours.
+// The boost of a neighbour is the sum of seed score * confidence over the seeds,
+// so the boost is on the same scale as the scores of the base retriever.
+func (c CoChangeRetriever) Retrieve(ctx context.Context, query string) ([]Result, error) {
+	results, baseErr := c.base.Retrieve(ctx, query)
+	if baseErr != nil && !IsPartial(baseErr) {
+		return nil, baseErr
+	}
+
+	paths := make(map[string]bool)
+	for fileInfo := range c.structure.Root.FileInfoGenerator() {
+		if !fileInfo.IsDir {
+			paths[fileInfo.Path] = true
+		}
+	}
+
+	index := make(map[string]int) // path -> index in results
+	for i, res := range results {
+		index[res.File.Path] = i
+	}
+
+	type boost struct {
+		path    string
+		score   float64
+		sources []Source
+	}
+	var boosts []*boost
+	boostMap := make(map[string]*boost)
+	for i, seed := range results {
+		if i >= c.seeds {
+			break
+		}
+		for _, n := range c.history.Neighbours(seed.File.Path, c.minCount) {
+			if !paths[n.Path] || n.Path == seed.File.Path {
+				continue
+			}
+			b, ok := boostMap[n.Path]
+			if !ok {
+				b = &boost{path: n.Path}
+				boostMap[n.Path] = b
+				boosts = append(boosts, b)
+			}
+			b.score += seed.Score * n.Confidence
+		}
+	}
+	sort.SliceStable(boosts, func(i, j int) bool {
+		return boosts[i].score > boosts[j].score
+	})
+
+	added := 0
+	for rank, b := range boosts {
+		source := Source{Retriever: c.Name(), Rank: rank + 1, Score: b.score}
+		if i, ok := index[b.path]; ok {
+			results[i].Score += b.score
+			results[i].Sources = append(results[i].Sources, source)
+			continue
+		}
+		if added >= c.maxNeighbours {
+			continue
+		}
+		content, err := c.reader.ReadContent(b.path)
+		if err != nil {
+			fmt.Printf("failed to load file content. skip: %v\n", err)
+			continue
+		}
+		results = append(results, Result{File: file.File{Path: b.path, Content: content}, Score: b.score, Sources: []Source{source}})
+		added++
+	}
+	fmt.Printf("Found %d files using co-change history\n", added)
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results, baseErr
+}
@@ -0,0 +1,45 @@
+package retriever
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/git"
+	"github.com/nakamasato/aicoder/internal/loader"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoChangeRetriever_Retrieve(t *testing.T) {
+	base := &MockRetriever{ReturnFiles: []file.File{{Path: "cmd/plan/cmd.go"}, {Path: "internal/reviewer/reviewer.go"}}}
+	history := git.NewCoChange(
+		[]string{"cmd/plan/cmd.go", "internal/planner/planner.go", "go.sum"},
+		[]string{"cmd/plan/cmd.go", "internal/planner/planner.go", "go.sum"},
+		[]string{"cmd/plan/cmd.go", "internal/reviewer/reviewer.go"},
+		[]string{"cmd/plan/cmd.go", "internal/reviewer/reviewer.go"},
+		[]string{"cmd/plan/cmd.go", "README.md"}, // below min count
+	)
+	structure := &loader.RepoStructure{
+		Root: loader.FileInfo{
+			IsDir: true,
+			Children: []loader.FileInfo{
+				{Path: "README.md"},
+				{Path: "cmd/plan/cmd.go"},
+				{Path: "internal/planner/planner.go"},
+				{Path: "internal/reviewer/reviewer.go"},
+			},
+		},
+	}
+	r := NewCoChangeRetriever(base, history, file.MockFileReader{Content: "content"}, structure)
+
+	results, err := r.Retrieve(context.Background(), "query")
+	assert.NoError(t, err)
+	var paths []string
+	for _, res := range results {
+		paths = append(paths, res.File.Path)
+	}
+	// go.sum is not in the repo structure and README.md is changed together only once
+	assert.Equal(t, []string{"cmd/plan/cmd.go", "internal/reviewer/reviewer.go", "internal/planner/planner.go"}, paths)
+	assert.Equal(t, "cochange", results[1].Sources[1].Retriever) // reviewer.go is boosted
+	assert.Equal(t, "content", results[2].File.Content)
+}
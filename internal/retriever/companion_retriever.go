@@ -0,0 +1,153 @@
+package retriever
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/loader"
+	"github.com/nakamasato/aicoder/internal/symbol"
+)
+
+const defaultMaxTestsPerFile = 3
+
+// TestCompanionRetriever pairs each Go file found by the base retriever with its _test.go siblings
+// and the test files referencing its exported symbols so that the planner can update tests together.
+type TestCompanionRetriever struct {
+	base     Retriever
+	reader   file.FileReader
+	index    *symbol.Index
+	tests    map[string]bool
+	maxTests int
+}
+
+type TestCompanionRetrieverOption func(*TestCompanionRetriever)
+
+// WithMaxTestsPerFile sets the maximum number of test files paired with each Go file.
+func WithMaxTestsPerFile(n int) TestCompanionRetrieverOption {
+	return func(t *TestCompanionRetriever) {
+		t.maxTests = n
+	}
+}
+
+func NewTestCompanionRetriever(base Retriever, reader file.FileReader, structure *loader.RepoStructure, opts ...TestCompanionRetrieverOption) *TestCompanionRetriever {
+	var paths []string
+	tests := make(map[string]bool)
+	for fileInfo := range structure.Root.FileInfoGenerator() {
+		if fileInfo.IsDir || filepath.Ext(fileInfo.Path) != ".go" {
+			continue
+		}
+		paths = append(paths, fileInfo.Path)
+		if file.IsGoTestFile(fileInfo.Path) {
+			tests[fileInfo.Path] = true
+		}
+	}
+	t := &TestCompanionRetriever{
+		base:     base,
+		reader:   reader,
+		index:    symbol.BuildIndex(paths, reader),
+		tests:    tests,
+		maxTests: defaultMaxTestsPerFile,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+func (t TestCompanionRetriever) Name() string {
+	return "test"
+}
+
+// Retrieve retrieves the files with the base retriever and adds the test files of the Go files right after them.
+// The score of a test file is half the score of the Go file.
+func (t TestCompanionRetriever) Retrieve(ctx context.Context, query string) ([]Result, error) {
+	results, baseErr := t.base.Retrieve(ctx, query)
+	if baseErr != nil && !IsPartial(baseErr) {
+		return nil, baseErr
+	}
+
+	found := make(map[string]bool)
+	for _, res := range results {
+		found[res.File.Path] = true
+	}
+
+	var paired []Result
+	added := 0
+	for _, res := range results {
+		paired = append(paired, res)
+		if filepath.Ext(res.File.Path) != ".go" || file.IsGoTestFile(res.File.Path) {
+			continue
+		}
+		for rank, path := range t.companions(res.File.Path) {
+			if found[path] {
+				continue
+			}
+			content, err := t.reader.ReadContent(path)
+			if err != nil {
+				fmt.Printf("failed to load file content. skip: %v\n", err)
+				continue
+			}
+			found[path] = true
+			score := res.Score / 2
+			paired = append(paired, Result{
+				File:    file.File{Path: path, Content: content},
+				Score:   score,
+				Sources: []Source{{Retriever: t.Name(), Rank: rank + 1, Score: score}},
+			})
+			added++
+		}
+	}
+	fmt.Printf("Found %d test files for the retrieved files\n", added)
+	return paired, baseErr
+}
+
+// companions returns the test files of the Go file: the conventional sibling first,
+// then the test files in the same directory and the other test files ordered by
+// the number of exported symbols of the file they reference.
+func (t TestCompanionRetriever) companions(path string) []string {
+	var companions []string
+	sibling := file.GoTestFileFor(path)
+	if t.tests[sibling] {
+		companions = append(companions, sibling)
+	}
+
+	refCount := make(map[string]int)
+	seen := make(map[string]bool)
+	for _, def := range t.index.Symbols(path) {
+		name := def.Name[strings.LastIndex(def.Name, ".")+1:]
+		if !ast.IsExported(name) || seen[name] {
+			continue
+		}
+		seen[name] = true
+		for _, ref := range t.index.References(name) {
+			if t.tests[ref] && ref != sibling {
+				refCount[ref]++
+			}
+		}
+	}
+	var refs []string
+	for ref := range refCount {
+		refs = append(refs, ref)
+	}
+	dir := filepath.Dir(path)
+	sort.Slice(refs, func(i, j int) bool {
+		iSameDir, jSameDir := filepath.Dir(refs[i]) == dir, filepath.Dir(refs[j]) == dir
+		if iSameDir != jSameDir {
+			return iSameDir
+		}
+		if refCount[refs[i]] != refCount[refs[j]] {
+			return refCount[refs[i]] > refCount[refs[j]]
+		}
+		return refs[i] < refs[j]
+	})
+	companions = append(companions, refs...)
+	if len(companions) > t.maxTests {
+		companions = companions[:t.maxTests]
+	}
+	return companions
+}
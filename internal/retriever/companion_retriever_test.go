@@ -0,0 +1,75 @@
+package retriever
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/loader"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestCompanionRetriever_Retrieve(t *testing.T) {
+	reader := MapFileReader{
+		"internal/applier/go_applier.go":      "package applier\n\nfunc ApplyGo() {}\n\nfunc helper() {}\n",
+		"internal/applier/go_applier_test.go": "package applier\n\nfunc TestApplyGo() { ApplyGo() }\n",
+		"internal/applier/applier_test.go":    "package applier\n\nfunc TestApply() { ApplyGo() }\n",
+		"cmd/apply/cmd_test.go":               "package apply\n\nfunc TestCmd() { applier.ApplyGo() }\n",
+		"internal/planner/planner_test.go":    "package planner\n\nfunc TestPlan() { helper() }\n",
+		"README.md":                           "# aicoder",
+	}
+	structure := &loader.RepoStructure{
+		Root: loader.FileInfo{
+			IsDir: true,
+			Children: []loader.FileInfo{
+				{Path: "README.md"},
+				{Path: "cmd/apply/cmd_test.go"},
+				{Path: "internal/applier/applier_test.go"},
+				{Path: "internal/applier/go_applier.go"},
+				{Path: "internal/applier/go_applier_test.go"},
+				{Path: "internal/planner/planner_test.go"},
+			},
+		},
+	}
+	base := &MockRetriever{ReturnFiles: []file.File{{Path: "internal/applier/go_applier.go"}, {Path: "README.md"}}}
+
+	tests := []struct {
+		name string
+		opts []TestCompanionRetrieverOption
+		want []string
+	}{
+		{
+			name: "sibling first, then same directory and references",
+			want: []string{
+				"internal/applier/go_applier.go",
+				"internal/applier/go_applier_test.go",
+				"internal/applier/applier_test.go",
+				"cmd/apply/cmd_test.go",
+				"README.md",
+			},
+		},
+		{
+			name: "limited",
+			opts: []TestCompanionRetrieverOption{WithMaxTestsPerFile(1)},
+			want: []string{
+				"internal/applier/go_applier.go",
+				"internal/applier/go_applier_test.go",
+				"README.md",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewTestCompanionRetriever(base, reader, structure, tt.opts...)
+			results, err := r.Retrieve(context.Background(), "query")
+			assert.NoError(t, err)
+			var paths []string
+			for _, res := range results {
+				paths = append(paths, res.File.Path)
+			}
+			assert.Equal(t, tt.want, paths)
+			assert.Equal(t, "test", results[1].Sources[0].Retriever)
+			assert.Equal(t, results[0].Score/2, results[1].Score)
+		})
+	}
+}
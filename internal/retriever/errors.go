@@ -0,0 +1,41 @@
+package retriever
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrSearch is returned when the vectorstore search fails.
+	ErrSearch = errors.New("search failed")
+	// ErrLLM is returned when LLM fails to generate or returns an unexpected response.
+	ErrLLM = errors.New("llm failed")
+	// ErrStaleIndex is returned when the index refers to a file that cannot be read.
+	// You might need to refresh the index by `aicoder load -r`.
+	ErrStaleIndex = errors.New("stale index")
+)
+
+// PartialError is returned together with the partial results when a part of the retrieval failed.
+// Callers can treat it as warnings and use the returned files.
+type PartialError struct {
+	Errors []error
+}
+
+func (e *PartialError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("partial results with %d error(s): %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+func (e *PartialError) Unwrap() []error {
+	return e.Errors
+}
+
+// IsPartial checks if the error only indicates partial results.
+func IsPartial(err error) bool {
+	var partialErr *PartialError
+	return errors.As(err, &partialErr)
+}
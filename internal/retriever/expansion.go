@@ -0,0 +1,88 @@
+package retriever
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/vectorstore"
+)
+
+// QueryExpansion is the rewritten queries used to search the vectorstore in addition to the original query.
+type QueryExpansion struct {
+	Reformulations      []string `json:"reformulations" jsonschema_description:"Reformulations of the query using the words likely to appear in the code and the file summaries (e.g. function names, package names, technical terms)"`
+	HypotheticalSummary string   `json:"hypothetical_summary" jsonschema_description:"A hypothetical summary of a file in the repository that is relevant to the query, written in the same style as the file summaries"`
+}
+
+var QueryExpansionSchemaParam = llm.GenerateSchema[QueryExpansion]("query_expansion", "Reformulations of the query and a hypothetical summary of the relevant file")
+
+const QUERY_EXPANSION_PROMPT = `The following query is used to search for relevant files in a repository by the similarity with the summaries of the files.
+The query may not share words with the summaries. Please provide %d reformulations of the query and a hypothetical summary of a file that is relevant to the query.
+
+Query: %s
+`
+
+// expandQuery returns the original query followed by the reformulations and the hypothetical summary (HyDE) generated by LLM.
+func expandQuery(ctx context.Context, llmClient llm.Client, query string, n int) ([]string, error) {
+	content, err := llmClient.GenerateCompletion(ctx,
+		[]llm.Message{
+			{Role: llm.RoleSystem, Content: "You are a helpful assistant that rewrites a search query for a code repository."},
+			{Role: llm.RoleUser, Content: fmt.Sprintf(QUERY_EXPANSION_PROMPT, n, query)},
+		},
+		QueryExpansionSchemaParam)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate completion: %w", err)
+	}
+
+	var expansion QueryExpansion
+	if err := json.Unmarshal([]byte(content), &expansion); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal query expansion: %w", err)
+	}
+
+	queries := []string{query}
+	seen := map[string]bool{query: true}
+	reformulations := expansion.Reformulations
+	if len(reformulations) > n {
+		reformulations = reformulations[:n]
+	}
+	for _, q := range append(reformulations, expansion.HypotheticalSummary) {
+		if q == "" || seen[q] {
+			continue
+		}
+		seen[q] = true
+		queries = append(queries, q)
+	}
+	return queries, nil
+}
+
+// mergeSearchResults merges the results of the expanded queries keeping the smallest distance for each file.
+// The first result must be the one of the original query, whose embedding is kept as the query embedding.
+func mergeSearchResults(results []*vectorstore.SearchResult, k int) *vectorstore.SearchResult {
+	var docs []vectorstore.DocumentWithScore
+	index := make(map[string]int)
+	for _, res := range results {
+		for _, doc := range *res.Documents {
+			if i, ok := index[doc.Document.Filepath]; ok {
+				if doc.Score < docs[i].Score {
+					docs[i] = doc
+				}
+				continue
+			}
+			index[doc.Document.Filepath] = len(docs)
+			docs = append(docs, doc)
+		}
+	}
+	sort.SliceStable(docs, func(i, j int) bool {
+		return docs[i].Score < docs[j].Score
+	})
+	if len(docs) > k {
+		docs = docs[:k]
+	}
+	merged := &vectorstore.SearchResult{Documents: &docs}
+	if len(results) > 0 {
+		merged.QueryEmbedding = results[0].QueryEmbedding
+	}
+	return merged
+}
@@ -0,0 +1,52 @@
+package retriever
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nakamasato/aicoder/config"
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/vectorstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandQuery(t *testing.T) {
+	llmClient := llm.DummyClient{ReturnValue: `{"reformulations": ["improve ReviewResult formatting", "make review output nicer", "print review comments"], "hypothetical_summary": "cmd/review/cmd.go prints the review result"}`}
+
+	queries, err := expandQuery(context.Background(), llmClient, "make review output nicer", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"make review output nicer",
+		"improve ReviewResult formatting", // duplicated query is removed
+		"cmd/review/cmd.go prints the review result",
+	}, queries)
+
+	_, err = expandQuery(context.Background(), llm.DummyClient{ReturnValue: "invalid"}, "query", 2)
+	assert.Error(t, err)
+}
+
+func TestMergeSearchResults(t *testing.T) {
+	doc := func(path string, score float64) vectorstore.DocumentWithScore {
+		return vectorstore.DocumentWithScore{Document: &vectorstore.Document{Filepath: path}, Score: score}
+	}
+	results := []*vectorstore.SearchResult{
+		{Documents: &[]vectorstore.DocumentWithScore{doc("a.go", 0.5), doc("b.go", 0.7)}, QueryEmbedding: []float32{1}},
+		{Documents: &[]vectorstore.DocumentWithScore{doc("b.go", 0.3), doc("c.go", 0.9)}, QueryEmbedding: []float32{2}},
+	}
+
+	merged := mergeSearchResults(results, 2)
+	assert.Equal(t, []vectorstore.DocumentWithScore{doc("b.go", 0.3), doc("a.go", 0.5)}, *merged.Documents)
+	assert.Equal(t, []float32{1}, merged.QueryEmbedding)
+}
+
+func TestVectorestoreRetriever_Search_QueryExpansion(t *testing.T) {
+	config := &config.AICoderConfig{Repository: "mockRepo", CurrentContext: "mockContext"}
+	llmClient := llm.DummyClient{ReturnValue: `{"reformulations": ["reformulated"], "hypothetical_summary": "summary"}`}
+	retriever := NewVectorstoreRetriever(&MockVectorStore{}, file.MockFileReader{}, config, WithQueryExpansion(llmClient, 1))
+
+	docs, err := retriever.Search(context.Background(), "test query")
+	assert.NoError(t, err)
+	assert.Len(t, docs, 2)
+	assert.Equal(t, "mock/file2.go", docs[0].Document.Filepath) // smaller distance first
+}
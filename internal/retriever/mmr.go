@@ -0,0 +1,41 @@
+package retriever
+
+import (
+	"github.com/nakamasato/aicoder/internal/vectorstore"
+)
+
+// maximalMarginalRelevance selects k documents that are relevant to the query but not similar to each other.
+// relevance[i] is the relevance of docs[i] to the query and lambda balances relevance (1) and diversity (0).
+// The documents are returned in the order of selection.
+func maximalMarginalRelevance(docs []vectorstore.DocumentWithScore, relevance []float64, k int, lambda float64) []vectorstore.DocumentWithScore {
+	if k > len(docs) {
+		k = len(docs)
+	}
+
+	selected := make([]vectorstore.DocumentWithScore, 0, k)
+	used := make([]bool, len(docs))
+	for len(selected) < k {
+		best := -1
+		var bestScore float64
+		for i, doc := range docs {
+			if used[i] {
+				continue
+			}
+			// similarity to the most similar document that is already selected
+			var maxSim float64
+			for _, s := range selected {
+				if sim := vectorstore.CosineSimilarity(doc.Embedding, s.Embedding); sim > maxSim {
+					maxSim = sim
+				}
+			}
+			score := lambda*relevance[i] - (1-lambda)*maxSim
+			if best == -1 || score > bestScore {
+				best = i
+				bestScore = score
+			}
+		}
+		used[best] = true
+		selected = append(selected, docs[best])
+	}
+	return selected
+}
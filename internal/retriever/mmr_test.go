@@ -0,0 +1,39 @@
+package retriever
+
+import (
+	"testing"
+
+	"github.com/nakamasato/aicoder/internal/vectorstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaximalMarginalRelevance(t *testing.T) {
+	docs := []vectorstore.DocumentWithScore{
+		{Document: &vectorstore.Document{Filepath: "pkg/a.go"}, Embedding: []float32{1, 0, 0}},
+		{Document: &vectorstore.Document{Filepath: "pkg/a_dup.go"}, Embedding: []float32{0.99, 0.01, 0}},
+		{Document: &vectorstore.Document{Filepath: "other/b.go"}, Embedding: []float32{0, 1, 0}},
+	}
+	relevance := []float64{1.0, 0.99, 0.7}
+
+	tests := []struct {
+		name     string
+		lambda   float64
+		k        int
+		expected []string
+	}{
+		{"relevance only", 1.0, 2, []string{"pkg/a.go", "pkg/a_dup.go"}},
+		{"balanced", 0.5, 2, []string{"pkg/a.go", "other/b.go"}},
+		{"k larger than docs", 0.5, 5, []string{"pkg/a.go", "other/b.go", "pkg/a_dup.go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected := maximalMarginalRelevance(docs, relevance, tt.k, tt.lambda)
+			var paths []string
+			for _, doc := range selected {
+				paths = append(paths, doc.Document.Filepath)
+			}
+			assert.Equal(t, tt.expected, paths)
+		})
+	}
+}
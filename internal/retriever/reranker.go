@@ -0,0 +1,106 @@
+package retriever
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/vectorstore"
+)
+
+// maxRelevanceScore is the maximum score that the reranker gives to a document.
+const maxRelevanceScore = 10
+
+type RelevanceScore struct {
+	Score  int    `json:"score" jsonschema_description:"Relevance score of the file to the query from 0 (irrelevant) to 10 (must be read or changed to answer the query)"`
+	Reason string `json:"reason" jsonschema_description:"Short reason for the score"`
+}
+
+var RelevanceScoreSchemaParam = llm.GenerateSchema[RelevanceScore]("relevance_score", "Relevance score of the file to the query")
+
+const RERANK_PROMPT = `Please rate how relevant the following file is to the query.
+
+Query: %s
+
+File: %s
+Summary:
+%s
+`
+
+// rerank scores each document against the query with LLM (cross-encoder style) and sorts them by the score.
+// At most concurrency documents are scored at the same time. The first error stops scoring the other documents.
+// The returned relevance is normalized to [0, 1] and in the same order as the returned documents.
+func rerank(ctx context.Context, llmClient llm.Client, query string, docs []vectorstore.DocumentWithScore, concurrency int) ([]vectorstore.DocumentWithScore, []float64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	scores := make([]int, len(docs))
+	errs := make([]error, len(docs))
+	sem := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+
+	for i, doc := range docs {
+		wg.Add(1)
+		go func(i int, doc vectorstore.DocumentWithScore) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			scores[i], errs[i] = scoreDocument(ctx, llmClient, query, doc)
+			if errs[i] != nil {
+				cancel() // stop scoring the other documents
+			}
+		}(i, doc)
+	}
+	wg.Wait()
+
+	// report the first error that is not caused by the cancellation
+	var firstErr error
+	for _, err := range errs {
+		if err != nil && (firstErr == nil || errors.Is(firstErr, context.Canceled)) {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+
+	// sort by the LLM score keeping the vector search order for ties
+	indices := make([]int, len(docs))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(a, b int) bool {
+		return scores[indices[a]] > scores[indices[b]]
+	})
+
+	reranked := make([]vectorstore.DocumentWithScore, len(docs))
+	relevance := make([]float64, len(docs))
+	for i, idx := range indices {
+		reranked[i] = docs[idx]
+		relevance[i] = float64(scores[idx]) / maxRelevanceScore
+	}
+	return reranked, relevance, nil
+}
+
+// scoreDocument scores the relevance of the document to the query with LLM.
+func scoreDocument(ctx context.Context, llmClient llm.Client, query string, doc vectorstore.DocumentWithScore) (int, error) {
+	content, err := llmClient.GenerateCompletion(ctx,
+		[]llm.Message{
+			{Role: llm.RoleSystem, Content: "You are a helpful assistant that ranks files in a repository by their relevance to a given query."},
+			{Role: llm.RoleUser, Content: fmt.Sprintf(RERANK_PROMPT, query, doc.Document.Filepath, doc.Document.Description)},
+		},
+		RelevanceScoreSchemaParam)
+	if err != nil {
+		return 0, fmt.Errorf("failed to score %s: %w", doc.Document.Filepath, err)
+	}
+
+	var score RelevanceScore
+	if err := json.Unmarshal([]byte(content), &score); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal relevance score of %s: %w", doc.Document.Filepath, err)
+	}
+	return min(max(score.Score, 0), maxRelevanceScore), nil
+}
@@ -0,0 +1,76 @@
+package retriever
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/vectorstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerank(t *testing.T) {
+	llmClient := llm.DummyClient{ReturnValue: `{"score": 15, "reason": "relevant"}`}
+	docs := []vectorstore.DocumentWithScore{
+		{Document: &vectorstore.Document{Filepath: "mock/file1.go"}},
+		{Document: &vectorstore.Document{Filepath: "mock/file2.go"}},
+	}
+
+	reranked, relevance, err := rerank(context.Background(), llmClient, "test query", docs, 2)
+	assert.NoError(t, err)
+	assert.Len(t, reranked, 2)
+	// ties keep the original order
+	assert.Equal(t, "mock/file1.go", reranked[0].Document.Filepath)
+	assert.Equal(t, "mock/file2.go", reranked[1].Document.Filepath)
+	// score is clamped to the max score
+	assert.Equal(t, []float64{1, 1}, relevance)
+}
+
+// scoringClient records the maximum number of concurrent calls and fails to score the file containing failingPath.
+type scoringClient struct {
+	llm.DummyClient
+	failingPath string
+	mu          sync.Mutex
+	running     int
+	maxRunning  int
+}
+
+func (c *scoringClient) GenerateCompletion(ctx context.Context, messages []llm.Message, schema llm.Schema) (string, error) {
+	c.mu.Lock()
+	c.running++
+	c.maxRunning = max(c.maxRunning, c.running)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.running--
+		c.mu.Unlock()
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if c.failingPath != "" && strings.Contains(messages[len(messages)-1].Content, c.failingPath) {
+		return "", errors.New("rate limited")
+	}
+	if strings.Contains(messages[len(messages)-1].Content, "file3.go") {
+		return `{"score": 9, "reason": "relevant"}`, nil
+	}
+	return `{"score": 1, "reason": "irrelevant"}`, nil
+}
+
+func TestRerank_Concurrency(t *testing.T) {
+	var docs []vectorstore.DocumentWithScore
+	for _, path := range []string{"file1.go", "file2.go", "file3.go", "file4.go", "file5.go"} {
+		docs = append(docs, vectorstore.DocumentWithScore{Document: &vectorstore.Document{Filepath: path}})
+	}
+
+	client := &scoringClient{}
+	reranked, _, err := rerank(context.Background(), client, "test query", docs, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "file3.go", reranked[0].Document.Filepath)
+	assert.LessOrEqual(t, client.maxRunning, 2)
+
+	_, _, err = rerank(context.Background(), &scoringClient{failingPath: "file2.go"}, "test query", docs, 2)
+	assert.ErrorContains(t, err, "failed to score file2.go: rate limited")
+}
@@ -0,0 +1,59 @@
+package retriever
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nakamasato/aicoder/internal/file"
+)
+
+// Result is a file retrieved by a retriever with its score and provenance.
+type Result struct {
+	File file.File
+	// Score is the relevance of the file. Higher is better.
+	// The scale depends on the retriever that produced the result.
+	Score float64
+	// Sources are the retrievers that found the file.
+	Sources []Source
+}
+
+// Source is where a result comes from.
+type Source struct {
+	Retriever string
+	Rank      int // 1-based rank in the retriever's results
+	Score     float64
+}
+
+func (s Source) String() string {
+	return fmt.Sprintf("%s#%d", s.Retriever, s.Rank)
+}
+
+func (r Result) String() string {
+	sources := make([]string, len(r.Sources))
+	for i, s := range r.Sources {
+		sources[i] = s.String()
+	}
+	return fmt.Sprintf("%s (score: %.4f) [%s]", r.File.Path, r.Score, strings.Join(sources, ", "))
+}
+
+// newResults makes results ranked in the given order from a single retriever.
+func newResults(retriever string, files []file.File, scores []float64) []Result {
+	results := make([]Result, len(files))
+	for i, f := range files {
+		results[i] = Result{
+			File:    f,
+			Score:   scores[i],
+			Sources: []Source{{Retriever: retriever, Rank: i + 1, Score: scores[i]}},
+		}
+	}
+	return results
+}
+
+// Files returns the files of the results.
+func Files(results []Result) []file.File {
+	files := make([]file.File, len(results))
+	for i, r := range results {
+		files[i] = r.File
+	}
+	return files
+}
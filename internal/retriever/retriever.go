@@ -4,8 +4,9 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"sort"
 
 	"github.com/nakamasato/aicoder/config"
 	"github.com/nakamasato/aicoder/internal/file"
@@ -15,49 +16,194 @@ import (
 )
 
 type Retriever interface {
-	Retrieve(ctx context.Context, query string) ([]file.File, error)
+	// Name is the name of the retriever shown as the source of the results.
+	Name() string
+	// Retrieve retrieves the files relevant to the query ordered by relevance.
+	Retrieve(ctx context.Context, query string) ([]Result, error)
 }
 
+const (
+	defaultTopK       = 10
+	defaultCandidates = 30
+	defaultMMRLambda  = 0.5
+	// defaultRerankConcurrency is the maximum number of documents scored by the reranker at the same time.
+	defaultRerankConcurrency = 5
+)
+
 type VectorestoreRetriever struct {
 	store  vectorstore.VectorStore
 	reader file.FileReader
 	config *config.AICoderConfig
+
+	topK       int
+	candidates int
+	mmr        bool
+	mmrLambda  float64
+	reranker   llm.Client
+	expander   llm.Client
+	expansions int
 }
 
-func NewVectorstoreRetriever(store vectorstore.VectorStore, reader file.FileReader, config *config.AICoderConfig) *VectorestoreRetriever {
-	return &VectorestoreRetriever{
-		store:  store,
-		reader: reader,
-		config: config,
+type VectorstoreRetrieverOption func(*VectorestoreRetriever)
+
+// WithTopK sets the number of files to retrieve.
+func WithTopK(k int) VectorstoreRetrieverOption {
+	return func(v *VectorestoreRetriever) {
+		v.topK = k
+	}
+}
+
+// WithCandidates sets the size of the candidate pool fetched from the vectorstore for MMR and reranking.
+func WithCandidates(n int) VectorstoreRetrieverOption {
+	return func(v *VectorestoreRetriever) {
+		v.candidates = n
+	}
+}
+
+// WithMMR enables maximal marginal relevance to diversify the retrieved files.
+// lambda is between 0 (max diversity) and 1 (max relevance).
+func WithMMR(lambda float64) VectorstoreRetrieverOption {
+	return func(v *VectorestoreRetriever) {
+		v.mmr = true
+		v.mmrLambda = lambda
+	}
+}
+
+// WithReranker enables reranking of the candidate pool with LLM.
+func WithReranker(llmClient llm.Client) VectorstoreRetrieverOption {
+	return func(v *VectorestoreRetriever) {
+		v.reranker = llmClient
+	}
+}
+
+// WithQueryExpansion enables rewriting the query with LLM into n reformulations and a hypothetical
+// summary of the relevant file (HyDE). All of them are searched and the results are merged.
+func WithQueryExpansion(llmClient llm.Client, n int) VectorstoreRetrieverOption {
+	return func(v *VectorestoreRetriever) {
+		v.expander = llmClient
+		v.expansions = n
+	}
+}
+
+func NewVectorstoreRetriever(store vectorstore.VectorStore, reader file.FileReader, config *config.AICoderConfig, opts ...VectorstoreRetrieverOption) *VectorestoreRetriever {
+	v := &VectorestoreRetriever{
+		store:      store,
+		reader:     reader,
+		config:     config,
+		topK:       defaultTopK,
+		candidates: defaultCandidates,
+		mmrLambda:  defaultMMRLambda,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Search searches the vectorstore for documents relevant to the query.
+// When MMR or reranking is enabled, a larger candidate pool is fetched and narrowed down to topK.
+func (v VectorestoreRetriever) Search(ctx context.Context, query string) ([]vectorstore.DocumentWithScore, error) {
+	k := v.topK
+	if v.mmr || v.reranker != nil {
+		k = max(v.candidates, v.topK)
+	}
+
+	res, err := v.search(ctx, query, k)
+	if err != nil {
+		return nil, err
+	}
+	docs := *res.Documents
+
+	// relevance of each document to the query used by MMR
+	relevance := make([]float64, len(docs))
+	for i, doc := range docs {
+		relevance[i] = vectorstore.CosineSimilarity(res.QueryEmbedding, doc.Embedding)
+	}
+
+	if v.reranker != nil {
+		// keep the vector search order if any document fails to be scored
+		if reranked, rerankedRelevance, err := rerank(ctx, v.reranker, query, docs, defaultRerankConcurrency); err != nil {
+			fmt.Printf("Failed to rerank, keeping the vector search order: %v\n", err)
+		} else {
+			docs, relevance = reranked, rerankedRelevance
+		}
+	}
+
+	if v.mmr {
+		docs = maximalMarginalRelevance(docs, relevance, v.topK, v.mmrLambda)
+	}
+
+	if len(docs) > v.topK {
+		docs = docs[:v.topK]
+	}
+	return docs, nil
+}
+
+// search searches the vectorstore with the query and the expanded queries if query expansion is enabled.
+func (v VectorestoreRetriever) search(ctx context.Context, query string, k int) (*vectorstore.SearchResult, error) {
+	if v.expander == nil {
+		res, err := v.store.Search(ctx, v.config.Repository, v.config.CurrentContext, query, k)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSearch, err)
+		}
+		return res, nil
+	}
+
+	queries, err := expandQuery(ctx, v.expander, query, v.expansions)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to expand query: %w", ErrLLM, err)
+	}
+	for i, q := range queries[1:] {
+		fmt.Printf("Expanded query %d: %s\n", i+1, q)
 	}
+	results, err := v.store.SearchMany(ctx, v.config.Repository, v.config.CurrentContext, queries, k)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSearch, err)
+	}
+	return mergeSearchResults(results, k), nil
 }
 
-func (v VectorestoreRetriever) Retrieve(ctx context.Context, query string) ([]file.File, error) {
+func (v VectorestoreRetriever) Name() string {
+	return "vectorstore"
+}
+
+// Retrieve retrieves the files relevant to the query from the vectorstore.
+// The score is 1 / (1 + distance) so that closer files have higher scores.
+// Files that are in the index but cannot be read are skipped and returned as ErrStaleIndex in PartialError.
+func (v VectorestoreRetriever) Retrieve(ctx context.Context, query string) ([]Result, error) {
 
 	// Get relevant files based on the query
-	res, err := v.store.Search(ctx, v.config.Repository, v.config.CurrentContext, query, 10)
+	docs, err := v.Search(ctx, query)
 	if err != nil {
-		log.Fatalf("failed to search: %v", err)
+		return nil, err
 	}
 
 	// Load file content
 	var files []file.File
+	var scores []float64
+	var errs []error
 	fileMap := make(map[string]bool)
 
-	fmt.Printf("Found %d files using embedding\n", len(*res.Documents))
-	for i, doc := range *res.Documents {
+	fmt.Printf("Found %d files using embedding\n", len(docs))
+	for i, doc := range docs {
 		if fileMap[doc.Document.Filepath] {
 			continue
 		}
 		fmt.Printf("%d: %s (score: %.2f)\n", i, doc.Document.Filepath, doc.Score)
 		content, err := v.reader.ReadContent(doc.Document.Filepath)
 		if err != nil {
-			log.Fatalf("failed to load file content. you might need to refresh loader by `aicoder load -r`: %v", err)
+			errs = append(errs, fmt.Errorf("%w: failed to load %s: %w", ErrStaleIndex, doc.Document.Filepath, err))
+			continue
 		}
 		files = append(files, file.File{Path: doc.Document.Filepath, Content: content})
+		scores = append(scores, 1/(1+doc.Score))
 		fileMap[doc.Document.Filepath] = true
 	}
-	return files, nil
+	results := newResults(v.Name(), files, scores)
+	if len(errs) > 0 {
+		return results, &PartialError{Errors: errs}
+	}
+	return results, nil
 }
 
 type LLMRetriever struct {
@@ -79,7 +225,13 @@ func NewLLMRetriever(llmClient llm.Client, reader file.FileReader, config *confi
 //go:embed templates/repo_summary.tmpl
 var RepoSummaryTemplate string
 
-func (l LLMRetriever) Retrieve(ctx context.Context, query string) ([]file.File, error) {
+func (l LLMRetriever) Name() string {
+	return "llm"
+}
+
+// Retrieve asks LLM to pick the relevant files from the repository structure.
+// The score is 1 / rank as LLM doesn't give scores.
+func (l LLMRetriever) Retrieve(ctx context.Context, query string) ([]Result, error) {
 	prompt := "Please extract files that are relevant to the given query.\nRepoStructure:\n```\n%s\n```\n"
 	content, err := l.llmClient.GenerateCompletion(ctx,
 		[]llm.Message{
@@ -89,16 +241,17 @@ func (l LLMRetriever) Retrieve(ctx context.Context, query string) ([]file.File,
 		llm.FileListSchemaParam,
 	)
 	if err != nil {
-		log.Fatalf("failed to generate completion: %v", err)
+		return nil, fmt.Errorf("%w: failed to generate completion: %w", ErrLLM, err)
 	}
 
 	var filelist llm.FileList
 	err = json.Unmarshal([]byte(content), &filelist)
 	if err != nil {
-		log.Fatalf("failed to unmarshal relevant files: %v", err)
+		return nil, fmt.Errorf("%w: failed to unmarshal relevant files: %w", ErrLLM, err)
 	}
 
 	var files []file.File
+	var scores []float64
 	fmt.Printf("Found %d files using LLM\n", len(filelist.Paths))
 	for i, path := range filelist.Paths {
 		fmt.Printf("%d: %s\n", i, path)
@@ -108,34 +261,123 @@ func (l LLMRetriever) Retrieve(ctx context.Context, query string) ([]file.File,
 			continue
 		}
 		files = append(files, file.File{Path: path, Content: content})
+		scores = append(scores, 1/float64(len(files)))
 	}
-	return files, nil
+	return newResults(l.Name(), files, scores), nil
 }
 
+const (
+	defaultRRFK             = 60
+	defaultMaxEnsembleFiles = 20
+)
+
+// EnsembleRetriever fuses the results of multiple retrievers with weighted reciprocal rank fusion (RRF).
 type EnsembleRetriever struct {
 	retrievers []Retriever
+	weights    map[string]float64
+	rrfK       float64
+	maxFiles   int
 }
 
-func NewEnsembleRetriever(retrievers ...Retriever) *EnsembleRetriever {
-	return &EnsembleRetriever{retrievers: retrievers}
+type EnsembleRetrieverOption func(*EnsembleRetriever)
+
+// WithWeight sets the weight of the retriever with the name. The default weight is 1.
+func WithWeight(name string, weight float64) EnsembleRetrieverOption {
+	return func(e *EnsembleRetriever) {
+		e.weights[name] = weight
+	}
 }
 
-func (e EnsembleRetriever) Retrieve(ctx context.Context, query string) ([]file.File, error) {
-	var files []file.File
-	fileMap := make(map[string]bool)
+// WithRRFK sets the constant k of RRF. Larger k reduces the advantage of the top ranks.
+func WithRRFK(k float64) EnsembleRetrieverOption {
+	return func(e *EnsembleRetriever) {
+		e.rrfK = k
+	}
+}
+
+// WithMaxFiles caps the number of fused results. 0 means no limit.
+func WithMaxFiles(n int) EnsembleRetrieverOption {
+	return func(e *EnsembleRetriever) {
+		e.maxFiles = n
+	}
+}
+
+func NewEnsembleRetriever(retrievers []Retriever, opts ...EnsembleRetrieverOption) *EnsembleRetriever {
+	e := &EnsembleRetriever{
+		retrievers: retrievers,
+		weights:    map[string]float64{},
+		rrfK:       defaultRRFK,
+		maxFiles:   defaultMaxEnsembleFiles,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+func (e EnsembleRetriever) Name() string {
+	return "ensemble"
+}
+
+func (e EnsembleRetriever) weight(name string) float64 {
+	if w, ok := e.weights[name]; ok {
+		return w
+	}
+	return 1
+}
+
+// Retrieve retrieves files with all the retrievers and fuses them by score = sum(weight / (k + rank)).
+// The fused results keep the sources of all the retrievers that found the file.
+// Failed retrievers don't stop the others: the files found by the healthy retrievers are returned
+// with PartialError holding the failures prefixed with the retriever names. An error is returned only if all the retrievers failed.
+func (e EnsembleRetriever) Retrieve(ctx context.Context, query string) ([]Result, error) {
+	var fused []*Result
+	resultMap := make(map[string]*Result)
+	var errs []error
+	failed := 0
 
 	for _, r := range e.retrievers {
-		fs, err := r.Retrieve(ctx, query)
-		if err != nil {
-			log.Fatalf("failed to retrieve files: %v", err)
+		results, err := r.Retrieve(ctx, query)
+		var partialErr *PartialError
+		if errors.As(err, &partialErr) {
+			for _, err := range partialErr.Errors {
+				errs = append(errs, fmt.Errorf("%s: %w", r.Name(), err))
+			}
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.Name(), err))
+			failed++
+			continue
 		}
-		for _, f := range fs {
-			if !fileMap[f.Path] { // Check if the file is already added
-				files = append(files, f)
-				fileMap[f.Path] = true // Mark the file as added
+		for i, res := range results {
+			fr, ok := resultMap[res.File.Path]
+			if !ok {
+				fr = &Result{File: res.File}
+				resultMap[res.File.Path] = fr
+				fused = append(fused, fr)
 			}
+			fr.Score += e.weight(r.Name()) / (e.rrfK + float64(i+1))
+			fr.Sources = append(fr.Sources, res.Sources...)
 		}
 	}
 
-	return files, nil
+	if len(e.retrievers) > 0 && failed == len(e.retrievers) {
+		return nil, fmt.Errorf("all retrievers failed: %w", errors.Join(errs...))
+	}
+
+	// sort by the fused score keeping the order of first appearance for ties
+	sort.SliceStable(fused, func(i, j int) bool {
+		return fused[i].Score > fused[j].Score
+	})
+	if e.maxFiles > 0 && len(fused) > e.maxFiles {
+		fused = fused[:e.maxFiles]
+	}
+	results := make([]Result, len(fused))
+	for i, fr := range fused {
+		results[i] = *fr
+	}
+
+	if len(errs) > 0 {
+		return results, &PartialError{Errors: errs}
+	}
+	return results, nil
 }
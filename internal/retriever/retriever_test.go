@@ -2,6 +2,8 @@ package retriever
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/nakamasato/aicoder/config"
@@ -25,11 +27,27 @@ func (m *MockVectorStore) Search(ctx context.Context, repo, context, query strin
 	}, nil
 }
 
+func (m *MockVectorStore) SearchMany(ctx context.Context, repo, context string, queries []string, limit int) ([]*vectorstore.SearchResult, error) {
+	var results []*vectorstore.SearchResult
+	for _, q := range queries {
+		res, err := m.Search(ctx, repo, context, q, limit)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
 func (m *MockVectorStore) AddDocument(ctx context.Context, doc *vectorstore.Document) error {
 	// Mock implementation of AddDocument
 	return nil
 }
 
+func (m *MockVectorStore) AddDocuments(ctx context.Context, docs []*vectorstore.Document) error {
+	return nil
+}
+
 func TestVectorestoreRetriever_Retrieve(t *testing.T) {
 	mockStore := &MockVectorStore{}
 	config := &config.AICoderConfig{Repository: "mockRepo", CurrentContext: "mockContext"}
@@ -41,8 +59,29 @@ func TestVectorestoreRetriever_Retrieve(t *testing.T) {
 	files, err := retriever.Retrieve(context.Background(), "test query")
 	assert.NoError(t, err)
 	assert.Len(t, files, 2)
-	assert.Equal(t, "mock/file1.go", files[0].Path)
-	assert.Equal(t, "mock/file2.go", files[1].Path)
+	assert.Equal(t, "mock/file1.go", files[0].File.Path)
+	assert.Equal(t, "mock/file2.go", files[1].File.Path)
+}
+
+func TestVectorestoreRetriever_Search(t *testing.T) {
+	mockStore := &MockVectorStore{}
+	config := &config.AICoderConfig{Repository: "mockRepo", CurrentContext: "mockContext"}
+	llmClient := llm.DummyClient{ReturnValue: `{"score": 5, "reason": "relevant"}`}
+	retriever := NewVectorstoreRetriever(mockStore, file.MockFileReader{}, config, WithTopK(1), WithMMR(0.5), WithReranker(llmClient))
+
+	docs, err := retriever.Search(context.Background(), "test query")
+	assert.NoError(t, err)
+	assert.Len(t, docs, 1)
+	assert.Equal(t, "mock/file1.go", docs[0].Document.Filepath)
+
+	// the vector search order is kept when the reranking fails
+	retriever = NewVectorstoreRetriever(mockStore, file.MockFileReader{}, config, WithTopK(2), WithReranker(&scoringClient{failingPath: "mock/file2.go"}))
+	docs, err = retriever.Search(context.Background(), "test query")
+	assert.NoError(t, err)
+	if assert.Len(t, docs, 2) {
+		assert.Equal(t, "mock/file1.go", docs[0].Document.Filepath)
+		assert.Equal(t, "mock/file2.go", docs[1].Document.Filepath)
+	}
 }
 
 func TestLLMRetriever_Retrieve(t *testing.T) {
@@ -68,17 +107,29 @@ func TestLLMRetriever_Retrieve(t *testing.T) {
 	files, err := retriever.Retrieve(context.Background(), "test query")
 	assert.NoError(t, err)
 	assert.Len(t, files, 2)
-	assert.Equal(t, "mock/file1.go", files[0].Path)
-	assert.Equal(t, "mock/file3.go", files[1].Path)
+	assert.Equal(t, "mock/file1.go", files[0].File.Path)
+	assert.Equal(t, "mock/file3.go", files[1].File.Path)
 }
 
 // Mock implementations for testing
 type MockRetriever struct {
+	name        string
 	ReturnFiles []file.File
 }
 
-func (m *MockRetriever) Retrieve(ctx context.Context, query string) ([]file.File, error) {
-	return m.ReturnFiles, nil
+func (m *MockRetriever) Name() string {
+	if m.name == "" {
+		return "mock"
+	}
+	return m.name
+}
+
+func (m *MockRetriever) Retrieve(ctx context.Context, query string) ([]Result, error) {
+	scores := make([]float64, len(m.ReturnFiles))
+	for i := range scores {
+		scores[i] = 1 / float64(i+1)
+	}
+	return newResults(m.Name(), m.ReturnFiles, scores), nil
 }
 
 // Test for EnsembleRetriever
@@ -96,12 +147,119 @@ func TestEnsembleRetriever_Retrieve(t *testing.T) {
 			{Path: "mock/file4.go"},
 		},
 	}
-	ensembleRetriever := NewEnsembleRetriever(mockRetriever1, mockRetriever2)
+	ensembleRetriever := NewEnsembleRetriever([]Retriever{mockRetriever1, mockRetriever2})
 
 	files, err := ensembleRetriever.Retrieve(context.Background(), "test query")
 	assert.NoError(t, err)
 	assert.Len(t, files, 3)
-	assert.Equal(t, "mock/file1.go", files[0].Path)
-	assert.Equal(t, "mock/file2.go", files[1].Path)
-	assert.Equal(t, "mock/file4.go", files[2].Path)
+	assert.Equal(t, "mock/file1.go", files[0].File.Path)
+	assert.Equal(t, "mock/file2.go", files[1].File.Path)
+	assert.Equal(t, "mock/file4.go", files[2].File.Path)
+	assert.Len(t, files[0].Sources, 2) // found by both retrievers
+}
+
+func TestEnsembleRetriever_Retrieve_Weights(t *testing.T) {
+	vr := &MockRetriever{name: "vectorstore", ReturnFiles: []file.File{{Path: "a.go"}, {Path: "b.go"}}}
+	sr := &MockRetriever{name: "symbol", ReturnFiles: []file.File{{Path: "c.go"}, {Path: "b.go"}}}
+
+	tests := []struct {
+		name    string
+		opts    []EnsembleRetrieverOption
+		want    []string
+		sources []string
+	}{
+		{
+			name:    "equal weights",
+			want:    []string{"b.go", "a.go", "c.go"},
+			sources: []string{"vectorstore#2", "symbol#2"},
+		},
+		{
+			name:    "symbol weighted",
+			opts:    []EnsembleRetrieverOption{WithWeight("symbol", 3)},
+			want:    []string{"b.go", "c.go", "a.go"},
+			sources: []string{"vectorstore#2", "symbol#2"},
+		},
+		{
+			name:    "capped",
+			opts:    []EnsembleRetrieverOption{WithWeight("vectorstore", 3), WithRRFK(1), WithMaxFiles(1)},
+			want:    []string{"a.go"},
+			sources: []string{"vectorstore#1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := NewEnsembleRetriever([]Retriever{vr, sr}, tt.opts...).Retrieve(context.Background(), "query")
+			assert.NoError(t, err)
+			var paths []string
+			for _, r := range results {
+				paths = append(paths, r.File.Path)
+			}
+			assert.Equal(t, tt.want, paths)
+			var sources []string
+			for _, s := range results[0].Sources {
+				sources = append(sources, s.String())
+			}
+			assert.Equal(t, tt.sources, sources)
+		})
+	}
+}
+
+type MockErrorRetriever struct {
+	Err error
+}
+
+func (m *MockErrorRetriever) Name() string {
+	return "error"
+}
+
+func (m *MockErrorRetriever) Retrieve(ctx context.Context, query string) ([]Result, error) {
+	return nil, m.Err
+}
+
+type ErrorFileReader struct{}
+
+func (r ErrorFileReader) ReadContent(path string) (string, error) {
+	return "", errors.New("file not found")
+}
+
+func TestVectorestoreRetriever_Retrieve_StaleIndex(t *testing.T) {
+	config := &config.AICoderConfig{Repository: "mockRepo", CurrentContext: "mockContext"}
+	retriever := NewVectorstoreRetriever(&MockVectorStore{}, ErrorFileReader{}, config)
+
+	files, err := retriever.Retrieve(context.Background(), "test query")
+	assert.Empty(t, files)
+	assert.True(t, IsPartial(err))
+	assert.ErrorIs(t, err, ErrStaleIndex)
+}
+
+func TestLLMRetriever_Retrieve_LLMError(t *testing.T) {
+	mockClient := llm.DummyClient{ReturnValue: "invalid json"}
+	retriever := NewLLMRetriever(mockClient, file.MockFileReader{}, &config.AICoderConfig{}, &loader.RepoStructure{})
+
+	_, err := retriever.Retrieve(context.Background(), "test query")
+	assert.ErrorIs(t, err, ErrLLM)
+}
+
+func TestEnsembleRetriever_Retrieve_PartialFailure(t *testing.T) {
+	healthy := &MockRetriever{ReturnFiles: []file.File{{Path: "mock/file1.go"}}}
+	broken := &MockErrorRetriever{Err: fmt.Errorf("%w: boom", ErrLLM)}
+
+	files, err := NewEnsembleRetriever([]Retriever{broken, healthy}).Retrieve(context.Background(), "test query")
+	assert.Len(t, files, 1)
+	assert.True(t, IsPartial(err))
+	assert.ErrorIs(t, err, ErrLLM)
+
+	// the partial errors of the retrievers are prefixed with their names
+	stale := NewVectorstoreRetriever(&MockVectorStore{}, ErrorFileReader{}, &config.AICoderConfig{Repository: "mockRepo", CurrentContext: "mockContext"})
+	files, err = NewEnsembleRetriever([]Retriever{stale, healthy}).Retrieve(context.Background(), "test query")
+	assert.Len(t, files, 1)
+	assert.True(t, IsPartial(err))
+	assert.ErrorIs(t, err, ErrStaleIndex)
+	assert.Contains(t, err.Error(), stale.Name()+": "+ErrStaleIndex.Error())
+
+	// all the retrievers failed
+	files, err = NewEnsembleRetriever([]Retriever{broken}).Retrieve(context.Background(), "test query")
+	assert.Nil(t, files)
+	assert.False(t, IsPartial(err))
+	assert.ErrorIs(t, err, ErrLLM)
 }
@@ -0,0 +1,89 @@
+package retriever
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/loader"
+	"github.com/nakamasato/aicoder/internal/symbol"
+)
+
+const defaultMaxSymbolFiles = 20
+
+// SymbolRetriever retrieves the files defining the identifiers mentioned in the query
+// (e.g. `UpdateDocuments`, `ReviewResult`) and the files referencing them.
+type SymbolRetriever struct {
+	reader   file.FileReader
+	index    *symbol.Index
+	maxFiles int
+}
+
+func NewSymbolRetriever(reader file.FileReader, structure *loader.RepoStructure) *SymbolRetriever {
+	var paths []string
+	for fileInfo := range structure.Root.FileInfoGenerator() {
+		if !fileInfo.IsDir {
+			paths = append(paths, fileInfo.Path)
+		}
+	}
+	return &SymbolRetriever{
+		reader:   reader,
+		index:    symbol.BuildIndex(paths, reader),
+		maxFiles: defaultMaxSymbolFiles,
+	}
+}
+
+func (s SymbolRetriever) Name() string {
+	return "symbol"
+}
+
+// Retrieve retrieves the defining files (score 1) followed by the referencing files (score 0.5).
+func (s SymbolRetriever) Retrieve(ctx context.Context, query string) ([]Result, error) {
+	var defining, referencing []string
+	for _, id := range symbol.ExtractIdentifiers(query) {
+		defs := s.index.Definitions(id)
+		name := id
+		if len(defs) == 0 && strings.Contains(id, ".") {
+			// package qualified identifier e.g. vectorstore.VectorStore
+			name = id[strings.LastIndex(id, ".")+1:]
+			defs = s.index.Definitions(name)
+		}
+		if len(defs) == 0 {
+			continue
+		}
+		fmt.Printf("Found symbol %s defined in %d place(s)\n", id, len(defs))
+		for _, def := range defs {
+			defining = append(defining, def.Path)
+		}
+		referencing = append(referencing, s.index.References(name)...)
+	}
+
+	// defining files come first, then callers and references
+	var files []file.File
+	var scores []float64
+	isDefining := make(map[string]bool)
+	for _, path := range defining {
+		isDefining[path] = true
+	}
+	fileMap := make(map[string]bool)
+	for _, path := range append(defining, referencing...) {
+		if fileMap[path] || len(files) >= s.maxFiles {
+			continue
+		}
+		fileMap[path] = true
+		content, err := s.reader.ReadContent(path)
+		if err != nil {
+			fmt.Printf("failed to load file content. skip: %v\n", err)
+			continue
+		}
+		files = append(files, file.File{Path: path, Content: content})
+		if isDefining[path] {
+			scores = append(scores, 1)
+		} else {
+			scores = append(scores, 0.5)
+		}
+	}
+	fmt.Printf("Found %d files using symbols\n", len(files))
+	return newResults(s.Name(), files, scores), nil
+}
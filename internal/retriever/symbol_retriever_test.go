@@ -0,0 +1,41 @@
+package retriever
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nakamasato/aicoder/internal/loader"
+	"github.com/stretchr/testify/assert"
+)
+
+type MapFileReader map[string]string
+
+func (m MapFileReader) ReadContent(path string) (string, error) {
+	return m[path], nil
+}
+
+func TestSymbolRetriever_Retrieve(t *testing.T) {
+	reader := MapFileReader{
+		"internal/reviewer/reviewer.go": "package reviewer\n\ntype ReviewResult struct{}\n",
+		"cmd/review/cmd.go":             "package review\n\nfunc runReview() {\n\tvar r reviewer.ReviewResult\n\t_ = r\n}\n",
+		"internal/planner/planner.go":   "package planner\n\nfunc GeneratePlan() {}\n",
+	}
+	structure := &loader.RepoStructure{
+		Root: loader.FileInfo{
+			IsDir: true,
+			Children: []loader.FileInfo{
+				{Path: "cmd/review/cmd.go"},
+				{Path: "internal/planner/planner.go"},
+				{Path: "internal/reviewer/reviewer.go"},
+			},
+		},
+	}
+	retriever := NewSymbolRetriever(reader, structure)
+
+	files, err := retriever.Retrieve(context.Background(), "Add a score to ReviewResult")
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, "internal/reviewer/reviewer.go", files[0].File.Path) // defining file first
+	assert.Equal(t, "cmd/review/cmd.go", files[1].File.Path)
+	assert.Greater(t, files[0].Score, files[1].Score)
+}
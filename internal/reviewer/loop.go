@@ -0,0 +1,63 @@
+package reviewer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/planner"
+)
+
+// ErrNotApproved is returned when the plan is not approved within the maximum number of attempts.
+var ErrNotApproved = errors.New("plan is not approved")
+
+// Attempt is a plan and its review in the auto review loop.
+type Attempt struct {
+	Number int
+	Plan   *planner.ChangesPlan
+	Review *ReviewResult
+}
+
+// PlanGenerator generates a plan. currentPlan and review are the previous plan and its review comment
+// to revise, which are empty in the first attempt unless the loop starts from an existing review.
+type PlanGenerator func(ctx context.Context, currentPlan *planner.ChangesPlan, review string) (*planner.ChangesPlan, error)
+
+// AutoReview repeats generating a plan, reviewing it and regenerating it with the review comment
+// until the plan is approved or maxAttempts is reached.
+// onAttempt is called after each review so that the caller can save the intermediate plans and reviews.
+// The last attempt is returned with ErrNotApproved if no plan is approved.
+// opts are passed to ReviewChanges.
+func AutoReview(ctx context.Context, llmClient llm.Client, generate PlanGenerator, currentPlan *planner.ChangesPlan, review string, maxAttempts int, onAttempt func(Attempt) error, opts ...ReviewOption) (*Attempt, error) {
+	if maxAttempts < 1 {
+		return nil, fmt.Errorf("max attempts must be positive: %d", maxAttempts)
+	}
+
+	var last *Attempt
+	for i := 1; i <= maxAttempts; i++ {
+		fmt.Printf("========== Attempt %d/%d ==========\n", i, maxAttempts)
+		plan, err := generate(ctx, currentPlan, review)
+		if err != nil {
+			return last, fmt.Errorf("failed to generate plan (attempt %d): %w", i, err)
+		}
+
+		result, err := ReviewChanges(ctx, llmClient, plan, opts...)
+		if err != nil {
+			return last, fmt.Errorf("failed to review plan (attempt %d): %w", i, err)
+		}
+		result.PlanId = plan.Id
+
+		last = &Attempt{Number: i, Plan: plan, Review: result}
+		if onAttempt != nil {
+			if err := onAttempt(*last); err != nil {
+				return last, err
+			}
+		}
+		if result.Approved {
+			fmt.Printf("Plan is approved at attempt %d\n", i)
+			return last, nil
+		}
+		currentPlan, review = plan, result.Comment
+	}
+	return last, fmt.Errorf("%w after %d attempts", ErrNotApproved, maxAttempts)
+}
@@ -11,9 +11,31 @@ import (
 )
 
 type ReviewResult struct {
-	PlanId   string `json:"plan_id" jsonschema_description:"ID of the Plan to review"`
-	Approved bool   `json:"result" jsonschema_description:"Whether the changeplan is approved or not"`
-	Comment  string `json:"comment" jsonschema_description:"Overall review comment for the changeplan"`
+	PlanId     string      `json:"plan_id" jsonschema_description:"ID of the Plan to review"`
+	Approved   bool        `json:"result" jsonschema_description:"Whether the changeplan is approved or not"`
+	Comment    string      `json:"comment" jsonschema_description:"Overall review comment for the changeplan"`
+	Violations []Violation `json:"violations" jsonschema_description:"Violations of the repository conventions. Empty if there are no conventions or all the changes follow them."`
+}
+
+// Violation is a change that doesn't follow a rule of the repository conventions.
+type Violation struct {
+	Change int    `json:"change" jsonschema_description:"Number of the change that violates the rule"`
+	Rule   string `json:"rule" jsonschema_description:"The rule of the conventions that is violated"`
+	Reason string `json:"reason" jsonschema_description:"How the change violates the rule and how to fix it"`
+}
+
+type reviewOptions struct {
+	conventions string
+}
+
+// ReviewOption configures ReviewChanges.
+type ReviewOption func(*reviewOptions)
+
+// WithConventions makes the reviewer check each change against the coding conventions of the repository (e.g. the content of AICODER.md).
+func WithConventions(conventions string) ReviewOption {
+	return func(o *reviewOptions) {
+		o.conventions = conventions
+	}
 }
 
 var (
@@ -32,15 +54,33 @@ Planned changes to review (PlanID: %s):
 - Please consider if the target file is correct and the change is necessary to achieve the goal.
 - If the changes are certain to achieve the goal, the result should be "true".
 - If target_type is file and multiple changes are made to the same file, please consider if the changes are necessary and reasonable to achieve the goal.
+`
+
+	CONVENTIONS_REVIEW_PROMPT = `
+--- Repository Conventions ---
+%s
+--- Repository Conventions end ---
+
+- Check each change against every rule of the repository conventions above.
+- Report each rule violated by a change in violations with the change number, the rule and the reason.
+- If any change violates the conventions, the result must be "false".
 `
 )
 
-// ReviewChanges applies changes based on the provided changesPlan.
-// If dryrun is true, it displays the diffs without modifying the actual files.
-func ReviewChanges(ctx context.Context, llmClient llm.Client, changesPlan *planner.ChangesPlan) (*ReviewResult, error) {
+// ReviewChanges reviews the changes of the changesPlan.
+// With WithConventions, the plan is not approved if any change violates the conventions,
+// and the violations are appended to the comment so that the plan can be revised with them.
+func ReviewChanges(ctx context.Context, llmClient llm.Client, changesPlan *planner.ChangesPlan, opts ...ReviewOption) (*ReviewResult, error) {
 	fmt.Println("Reviewing changes for query:", changesPlan.Query)
+	options := reviewOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
 
 	message := fmt.Sprintf(REVIEW_PROMPT, changesPlan.Query, changesPlan.Id, makeChangeString(&changesPlan.Changes))
+	if options.conventions != "" {
+		message += fmt.Sprintf(CONVENTIONS_REVIEW_PROMPT, options.conventions)
+	}
 
 	content, err := llmClient.GenerateCompletion(ctx,
 		[]llm.Message{
@@ -57,6 +97,10 @@ func ReviewChanges(ctx context.Context, llmClient llm.Client, changesPlan *plann
 	if err := json.Unmarshal([]byte(content), &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal review result: %w", err)
 	}
+	if len(result.Violations) > 0 {
+		result.Approved = false
+		result.Comment += "\n" + makeViolationString(result.Violations)
+	}
 
 	return &result, nil
 }
@@ -65,11 +109,20 @@ func makeChangeString(changes *[]planner.BlockChange) string {
 	var builder strings.Builder
 	for i, change := range *changes {
 		builder.WriteString(fmt.Sprintf(`---- change %d -----
-Path: %s, Type: %s, Name: %s,
+Action: %s, Path: %s, Type: %s, Name: %s,
 NewContent: %s
 ----- change %d end ----
-`, i, change.Block.Path, change.Block.TargetType, change.Block.TargetName, change.NewContent, i))
+`, i, change.GetAction(), change.Block.Path, change.Block.TargetType, change.Block.TargetName, change.NewContent, i))
 	}
 	changesString := builder.String()
 	return changesString
 }
+
+func makeViolationString(violations []Violation) string {
+	var builder strings.Builder
+	builder.WriteString("Violations of the repository conventions:\n")
+	for _, v := range violations {
+		builder.WriteString(fmt.Sprintf("- change %d violates %q: %s\n", v.Change, v.Rule, v.Reason))
+	}
+	return builder.String()
+}
@@ -38,3 +38,88 @@ func TestReviewChanges(t *testing.T) {
 	_, err := ReviewChanges(context.Background(), mockLLMClient, changesPlan)
 	assert.NoError(t, err)
 }
+
+// promptClient returns the ReturnValue and records the last user prompt.
+type promptClient struct {
+	llm.DummyClient
+	prompt string
+}
+
+func (c *promptClient) GenerateCompletion(ctx context.Context, messages []llm.Message, schema llm.Schema) (string, error) {
+	c.prompt = messages[len(messages)-1].Content
+	return c.ReturnValue, nil
+}
+
+func TestReviewChanges_Conventions(t *testing.T) {
+	changesPlan := &planner.ChangesPlan{
+		Id:      "123",
+		Query:   "Add a client",
+		Changes: []planner.BlockChange{{Block: planner.Block{Path: "client.go", TargetType: "function", TargetName: "NewClient"}, NewContent: "func NewClient() *Client"}},
+	}
+
+	// approved without conventions
+	client := &promptClient{DummyClient: llm.DummyClient{ReturnValue: `{"plan_id": "123", "result": true, "comment": "Looks good to me", "violations": []}`}}
+	result, err := ReviewChanges(context.Background(), client, changesPlan)
+	assert.NoError(t, err)
+	assert.True(t, result.Approved)
+	assert.NotContains(t, client.prompt, "Repository Conventions")
+
+	// violations reject the plan even if the result is true
+	client.ReturnValue = `{"plan_id": "123", "result": true, "comment": "Looks good to me", "violations": [{"change": 0, "rule": "Use functional options", "reason": "NewClient should accept ...ClientOption"}]}`
+	result, err = ReviewChanges(context.Background(), client, changesPlan, WithConventions("- Use functional options"))
+	assert.NoError(t, err)
+	assert.False(t, result.Approved)
+	assert.Contains(t, client.prompt, "- Use functional options")
+	assert.Contains(t, result.Comment, `change 0 violates "Use functional options": NewClient should accept ...ClientOption`)
+}
+
+func TestAutoReview(t *testing.T) {
+	tests := []struct {
+		name         string
+		review       string
+		maxAttempts  int
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			name:         "approved at first attempt",
+			review:       `{"plan_id": "", "result": true, "comment": "Looks good to me"}`,
+			maxAttempts:  3,
+			wantAttempts: 1,
+		},
+		{
+			name:         "not approved",
+			review:       `{"plan_id": "", "result": false, "comment": "Please add tests"}`,
+			maxAttempts:  3,
+			wantAttempts: 3,
+			wantErr:      ErrNotApproved,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reviews []string
+			generate := func(ctx context.Context, currentPlan *planner.ChangesPlan, review string) (*planner.ChangesPlan, error) {
+				reviews = append(reviews, review)
+				return &planner.ChangesPlan{Id: "plan-1", Query: "goal"}, nil
+			}
+			var attempts []Attempt
+			last, err := AutoReview(context.Background(), llm.DummyClient{ReturnValue: tt.review}, generate, nil, "", tt.maxAttempts, func(a Attempt) error {
+				attempts = append(attempts, a)
+				return nil
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, attempts, tt.wantAttempts)
+			assert.Equal(t, tt.wantAttempts, last.Number)
+			assert.Equal(t, "plan-1", last.Review.PlanId)
+			// the review comment is passed to the next attempt
+			assert.Equal(t, "", reviews[0])
+			if tt.wantAttempts > 1 {
+				assert.Equal(t, "Please add tests", reviews[1])
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package snapshot
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nakamasato/aicoder/ent"
+	"github.com/nakamasato/aicoder/ent/document"
+	"github.com/pgvector/pgvector-go"
+)
+
+// Version is the version of the snapshot format.
+const Version = 1
+
+// importBatchSize is the number of documents upserted in a single bulk insert.
+const importBatchSize = 500
+
+// Snapshot is a portable dump of the documents of a repository and context.
+// It contains everything needed to reuse the summaries and embeddings without calling LLM.
+type Snapshot struct {
+	Version    int        `json:"version"`
+	Repository string     `json:"repository"`
+	Context    string     `json:"context"`
+	Commit     string     `json:"commit,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	Documents  []Document `json:"documents"`
+}
+
+// Document is a document in the snapshot.
+type Document struct {
+	Filepath       string    `json:"filepath"`
+	Description    string    `json:"description"`
+	BlobHash       string    `json:"blob_hash,omitempty"`
+	EmbeddingModel string    `json:"embedding_model,omitempty"`
+	Embedding      []float32 `json:"embedding"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// EmbeddingModels returns the distinct embedding models used in the snapshot.
+func (s *Snapshot) EmbeddingModels() []string {
+	var models []string
+	seen := map[string]bool{}
+	for _, doc := range s.Documents {
+		if !seen[doc.EmbeddingModel] {
+			seen[doc.EmbeddingModel] = true
+			models = append(models, doc.EmbeddingModel)
+		}
+	}
+	return models
+}
+
+// CheckEmbeddingModel returns an error if the documents in the snapshot are embedded with a model other than the given model,
+// as the embeddings of different models cannot be compared in the similarity search.
+func (s *Snapshot) CheckEmbeddingModel(model string) error {
+	for _, m := range s.EmbeddingModels() {
+		if m != model {
+			return fmt.Errorf("the snapshot is embedded with %s but the current embedding model is %s", strings.Join(s.EmbeddingModels(), ","), model)
+		}
+	}
+	return nil
+}
+
+// Export reads all the documents of the repository and context from the database.
+func Export(ctx context.Context, entClient *ent.Client, repository, context, commit string) (*Snapshot, error) {
+	docs, err := entClient.Document.Query().
+		Where(document.RepositoryEQ(repository), document.ContextEQ(context)).
+		Order(ent.Asc(document.FieldFilepath)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query documents: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		Version:    Version,
+		Repository: repository,
+		Context:    context,
+		Commit:     commit,
+		CreatedAt:  time.Now(),
+		Documents:  make([]Document, 0, len(docs)),
+	}
+	for _, doc := range docs {
+		snapshot.Documents = append(snapshot.Documents, Document{
+			Filepath:       doc.Filepath,
+			Description:    doc.Description,
+			BlobHash:       doc.BlobHash,
+			EmbeddingModel: doc.EmbeddingModel,
+			Embedding:      doc.Embedding.Slice(),
+			UpdatedAt:      doc.UpdatedAt,
+		})
+	}
+	return snapshot, nil
+}
+
+// Import upserts the documents in the snapshot into the database under the given repository and context.
+// It returns the number of imported documents.
+func Import(ctx context.Context, entClient *ent.Client, snapshot *Snapshot, repository, context string) (int, error) {
+	if snapshot.Version != Version {
+		return 0, fmt.Errorf("unsupported snapshot version: %d", snapshot.Version)
+	}
+
+	for start := 0; start < len(snapshot.Documents); start += importBatchSize {
+		end := min(start+importBatchSize, len(snapshot.Documents))
+		builders := make([]*ent.DocumentCreate, 0, end-start)
+		for _, doc := range snapshot.Documents[start:end] {
+			builders = append(builders, entClient.Document.Create().
+				SetRepository(repository).
+				SetContext(context).
+				SetFilepath(doc.Filepath).
+				SetDescription(doc.Description).
+				SetBlobHash(doc.BlobHash).
+				SetEmbeddingModel(doc.EmbeddingModel).
+				SetEmbedding(pgvector.NewVector(doc.Embedding)).
+				SetUpdatedAt(doc.UpdatedAt))
+		}
+		err := entClient.Document.CreateBulk(builders...).
+			OnConflictColumns(document.FieldRepository, document.FieldContext, document.FieldFilepath).
+			UpdateNewValues().
+			Exec(ctx)
+		if err != nil {
+			return start, fmt.Errorf("failed to import documents [%d:%d]: %w", start, end, err)
+		}
+	}
+	return len(snapshot.Documents), nil
+}
+
+// Write writes the snapshot as JSON. The output is gzip-compressed if the path ends with ".gz".
+func Write(snapshot *Snapshot, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer f.Close()
+
+	if !strings.HasSuffix(path, ".gz") {
+		if err := json.NewEncoder(f).Encode(snapshot); err != nil {
+			return fmt.Errorf("failed to encode snapshot: %w", err)
+		}
+		return nil
+	}
+
+	gw := gzip.NewWriter(f)
+	if err := json.NewEncoder(gw).Encode(snapshot); err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("failed to compress snapshot: %w", err)
+	}
+	return nil
+}
+
+// Read reads the snapshot written by Write.
+func Read(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gzip: %w", err)
+		}
+		defer gr.Close()
+		r = gr
+	}
+
+	var snapshot Snapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,62 @@
+package snapshot
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteRead(t *testing.T) {
+	snap := &Snapshot{
+		Version:    Version,
+		Repository: "aicoder",
+		Context:    "default",
+		Commit:     "abc123",
+		CreatedAt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Documents: []Document{
+			{Filepath: "main.go", Description: "entrypoint", BlobHash: "hash1", EmbeddingModel: "model-a", Embedding: []float32{0.1, 0.2}},
+			{Filepath: "cmd/root.go", Description: "root command", BlobHash: "hash2", EmbeddingModel: "model-a", Embedding: []float32{0.3, 0.4}},
+		},
+	}
+
+	for _, name := range []string{"snapshot.json", "snapshot.json.gz"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			assert.NoError(t, Write(snap, path))
+
+			got, err := Read(path)
+			assert.NoError(t, err)
+			assert.Equal(t, snap.Repository, got.Repository)
+			assert.Equal(t, snap.Commit, got.Commit)
+			assert.Equal(t, snap.Documents, got.Documents)
+		})
+	}
+}
+
+func TestEmbeddingModels(t *testing.T) {
+	snap := &Snapshot{
+		Documents: []Document{
+			{EmbeddingModel: "model-a"},
+			{EmbeddingModel: "model-b"},
+			{EmbeddingModel: "model-a"},
+		},
+	}
+	assert.Equal(t, []string{"model-a", "model-b"}, snap.EmbeddingModels())
+}
+
+func TestCheckEmbeddingModel(t *testing.T) {
+	snap := &Snapshot{Documents: []Document{{EmbeddingModel: "model-a"}, {EmbeddingModel: "model-a"}}}
+	assert.NoError(t, snap.CheckEmbeddingModel("model-a"))
+	assert.EqualError(t, snap.CheckEmbeddingModel("model-b"), "the snapshot is embedded with model-a but the current embedding model is model-b")
+
+	snap.Documents = append(snap.Documents, Document{EmbeddingModel: "model-b"})
+	assert.Error(t, snap.CheckEmbeddingModel("model-a"))
+}
+
+func TestImportUnsupportedVersion(t *testing.T) {
+	_, err := Import(context.Background(), nil, &Snapshot{Version: Version + 1}, "aicoder", "default")
+	assert.Error(t, err)
+}
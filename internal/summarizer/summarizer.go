@@ -1,21 +1,40 @@
 package summarizer
 
 import (
+	"bytes"
 	"context"
+	_ "embed"
 	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/template"
 
 	"github.com/nakamasato/aicoder/config"
 	"github.com/nakamasato/aicoder/ent"
 	"github.com/nakamasato/aicoder/ent/document"
 	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/prompt"
 	"github.com/nakamasato/aicoder/internal/vectorstore"
 )
 
+//go:embed templates/summarize_repo.tmpl
+var summarizeRepoTemplate string
+
+// summarizeRepoData is the data passed to the template to summarize the repository.
+type summarizeRepoData struct {
+	Repository         string
+	Context            string
+	DirectorySummaries string
+	Language           Language
+}
+
+func init() {
+	prompt.RegisterTemplate(prompt.Spec{Name: "summarize_repo.tmpl", Default: summarizeRepoTemplate, Data: summarizeRepoData{}})
+}
+
 type Language string
 
 const (
@@ -149,9 +168,17 @@ func (s *service) UpdateRepoSummary(ctx context.Context, language Language, outp
 	}
 
 	// Generate final repository summary
-	prompt := fmt.Sprintf(llm.SUMMARIZE_REPO_CONTENT_PROMPT, s.config.Repository, s.config.CurrentContext, strings.Join(directorySummariesText, "\n\n"), language)
+	summarizePrompt, err := s.makeSummarizeRepoPrompt(summarizeRepoData{
+		Repository:         s.config.Repository,
+		Context:            s.config.CurrentContext,
+		DirectorySummaries: strings.Join(directorySummariesText, "\n\n"),
+		Language:           language,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to make prompt: %v", err)
+	}
 	messages := []llm.Message{
-		{Role: llm.RoleSystem, Content: prompt},
+		{Role: llm.RoleSystem, Content: summarizePrompt},
 	}
 	res, err := s.llmClient.GenerateCompletion(ctx, messages, RepoSummarySchemaParam)
 	if err != nil {
@@ -183,6 +210,23 @@ func (s *service) UpdateRepoSummary(ctx context.Context, language Language, outp
 	return res, nil
 }
 
+// makeSummarizeRepoPrompt renders summarize_repo.tmpl overridden in the prompts directory or the default one.
+func (s *service) makeSummarizeRepoPrompt(data summarizeRepoData) (string, error) {
+	templatefile, err := prompt.NewLoader(s.config.Prompts.Dir).Template("summarize_repo.tmpl")
+	if err != nil {
+		return "", err
+	}
+	tmpl, err := template.New("template").Parse(templatefile)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute template: %v", err)
+	}
+	return buf.String(), nil
+}
+
 // ReadSummary reads the summary from the given file
 func ReadSummary(ctx context.Context, filename string) (*OverallSummary, error) {
 	// Read the file content
@@ -3,6 +3,7 @@ package summarizer
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	_ "github.com/lib/pq"
@@ -59,3 +60,22 @@ func TestSummarizeRepo(t *testing.T) {
 	// Additional assertions can be added here to verify the behavior
 	assert.EqualValues(t, `{"overview":"A simple Go project.","features":["Feature 1","Feature 2"],"configuration":"config.yaml","environment_variables":[{"name":"API_KEY","desc":"API key for authentication","required":true}],"directory_structure":"src/\n  main.go - Entry point\n  utils/ - Utility functions","entrypoints":["main.go"],"important_files":["README.md","LICENSE"],"important_functions":["InitConfig","StartServer"],"dependencies":"graph TD;\n  A-->B;\n  B-->C;","technologies":["Go","Cobra"]}`, summary)
 }
+
+func TestMakeSummarizeRepoPrompt(t *testing.T) {
+	data := summarizeRepoData{Repository: "test-repo", Context: "test-context", DirectorySummaries: "Directory cmd", Language: LanguageJapanese}
+
+	s := NewService(&config.AICoderConfig{}, nil, nil, nil)
+	got, err := s.makeSummarizeRepoPrompt(data)
+	assert.NoError(t, err)
+	assert.Contains(t, got, "Name: test-repo\n\nTarget Directory: test-context\n\nFiles:\n\nDirectory cmd\n\nOutput language: ja\n")
+
+	// overridden in the prompts directory
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "summarize_repo.tmpl"), []byte("Summarize {{.Repository}} in {{.Language}}"), 0644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	s = NewService(&config.AICoderConfig{Prompts: config.PromptsConfig{Dir: dir}}, nil, nil, nil)
+	got, err = s.makeSummarizeRepoPrompt(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Summarize test-repo in ja", got)
+}
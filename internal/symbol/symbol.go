@@ -0,0 +1,263 @@
+package symbol
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/nakamasato/aicoder/internal/file"
+)
+
+type Kind string
+
+const (
+	KindFunc     Kind = "func"
+	KindMethod   Kind = "method"
+	KindType     Kind = "type"
+	KindVar      Kind = "var"
+	KindConst    Kind = "const"
+	KindHCLBlock Kind = "hcl_block"
+)
+
+// Definition is a declaration of a symbol.
+type Definition struct {
+	Name string // e.g. UpdateDocuments, service.UpdateDocuments, google_storage_bucket.example
+	Kind Kind
+	Path string
+	Line int
+}
+
+// Index is an index of symbol definitions and the files referencing them.
+type Index struct {
+	definitions map[string][]Definition
+	references  map[string]map[string]bool // identifier -> set of paths
+}
+
+// BuildIndex builds the symbol index of the Go and HCL files.
+// Files that cannot be read or parsed are skipped.
+func BuildIndex(paths []string, reader file.FileReader) *Index {
+	idx := &Index{
+		definitions: map[string][]Definition{},
+		references:  map[string]map[string]bool{},
+	}
+	for _, path := range paths {
+		ext := filepath.Ext(path)
+		if ext != ".go" && ext != ".hcl" && ext != ".tf" {
+			continue
+		}
+		content, err := reader.ReadContent(path)
+		if err != nil {
+			fmt.Printf("failed to read %s. skip: %v\n", path, err)
+			continue
+		}
+		if ext == ".go" {
+			err = idx.addGo(path, content)
+		} else {
+			err = idx.addHCL(path, content)
+		}
+		if err != nil {
+			fmt.Printf("failed to index %s. skip: %v\n", path, err)
+		}
+	}
+	return idx
+}
+
+func (idx *Index) addDefinition(def Definition) {
+	idx.definitions[def.Name] = append(idx.definitions[def.Name], def)
+}
+
+func (idx *Index) addReference(name, path string) {
+	if idx.references[name] == nil {
+		idx.references[name] = map[string]bool{}
+	}
+	idx.references[name][path] = true
+}
+
+func (idx *Index) addGo(path, content string) error {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, path, content, parser.SkipObjectResolution)
+	if err != nil {
+		return err
+	}
+
+	for _, decl := range node.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			line := fset.Position(d.Pos()).Line
+			if recv := file.ReceiverName(d); recv != "" {
+				// index by both method name and receiver qualified name
+				idx.addDefinition(Definition{Name: recv + "." + d.Name.Name, Kind: KindMethod, Path: path, Line: line})
+				idx.addDefinition(Definition{Name: d.Name.Name, Kind: KindMethod, Path: path, Line: line})
+			} else {
+				idx.addDefinition(Definition{Name: d.Name.Name, Kind: KindFunc, Path: path, Line: line})
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					idx.addDefinition(Definition{Name: s.Name.Name, Kind: KindType, Path: path, Line: fset.Position(s.Pos()).Line})
+				case *ast.ValueSpec:
+					kind := KindVar
+					if d.Tok == token.CONST {
+						kind = KindConst
+					}
+					for _, name := range s.Names {
+						if name.Name == "_" {
+							continue
+						}
+						idx.addDefinition(Definition{Name: name.Name, Kind: kind, Path: path, Line: fset.Position(name.Pos()).Line})
+					}
+				}
+			}
+		}
+	}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			idx.addReference(ident.Name, path)
+		}
+		return true
+	})
+	return nil
+}
+
+func (idx *Index) addHCL(path, content string) error {
+	f, diags := hclwrite.ParseConfig([]byte(content), path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	var walk func(body *hclwrite.Body)
+	walk = func(body *hclwrite.Body) {
+		for _, block := range body.Blocks() {
+			labels := block.Labels()
+			if len(labels) > 0 {
+				idx.addDefinition(Definition{Name: strings.Join(labels, "."), Kind: KindHCLBlock, Path: path})
+				if len(labels) > 1 {
+					idx.addDefinition(Definition{Name: labels[len(labels)-1], Kind: KindHCLBlock, Path: path})
+				}
+			}
+			walk(block.Body())
+		}
+	}
+	walk(f.Body())
+
+	for _, tok := range f.BuildTokens(nil) {
+		if tok.Type == hclsyntax.TokenIdent {
+			idx.addReference(string(tok.Bytes), path)
+		}
+	}
+	return nil
+}
+
+// Definitions returns the definitions of the symbol.
+func (idx *Index) Definitions(name string) []Definition {
+	return idx.definitions[name]
+}
+
+// References returns the files referencing the symbol sorted by path.
+// The last segment is used for receiver qualified or dotted names.
+func (idx *Index) References(name string) []string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	var paths []string
+	for path := range idx.references[name] {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Methods returns the methods with the name qualified with the receiver types (e.g. Client.Search for Search)
+// sorted by the qualified name.
+func (idx *Index) Methods(name string) []Definition {
+	var defs []Definition
+	for qualified, ds := range idx.definitions {
+		if !strings.HasSuffix(qualified, "."+name) {
+			continue
+		}
+		for _, d := range ds {
+			if d.Kind == KindMethod {
+				defs = append(defs, d)
+			}
+		}
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// Symbols returns the definitions in the file sorted by line.
+func (idx *Index) Symbols(path string) []Definition {
+	var defs []Definition
+	seen := map[string]bool{}
+	for _, ds := range idx.definitions {
+		for _, d := range ds {
+			if d.Path == path && !seen[string(d.Kind)+d.Name] {
+				seen[string(d.Kind)+d.Name] = true
+				defs = append(defs, d)
+			}
+		}
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		if defs[i].Line != defs[j].Line {
+			return defs[i].Line < defs[j].Line
+		}
+		return defs[i].Name < defs[j].Name
+	})
+	return defs
+}
+
+var (
+	backtickPattern   = regexp.MustCompile("`([^`]+)`")
+	identifierPattern = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)*`)
+)
+
+// ExtractIdentifiers extracts the words in the query that look like code identifiers.
+// A word is considered as an identifier if it's quoted with backticks, or it's camelCase/PascalCase
+// (has an upper case letter after the first character), or it contains '_' or '.'.
+// Plain English words like "Update" or "review" are ignored.
+func ExtractIdentifiers(query string) []string {
+	var identifiers []string
+	seen := map[string]bool{}
+	add := func(id string) {
+		id = strings.Trim(id, ".")
+		if id != "" && !seen[id] {
+			seen[id] = true
+			identifiers = append(identifiers, id)
+		}
+	}
+
+	for _, m := range backtickPattern.FindAllStringSubmatch(query, -1) {
+		for _, id := range identifierPattern.FindAllString(m[1], -1) {
+			add(id)
+		}
+	}
+	for _, id := range identifierPattern.FindAllString(query, -1) {
+		if looksLikeIdentifier(id) {
+			add(id)
+		}
+	}
+	return identifiers
+}
+
+func looksLikeIdentifier(word string) bool {
+	if strings.ContainsAny(word, "_.") {
+		return true
+	}
+	for i, r := range word {
+		if i > 0 && unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package symbol
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mapReader map[string]string
+
+func (m mapReader) ReadContent(path string) (string, error) {
+	content, ok := m[path]
+	if !ok {
+		return "", fmt.Errorf("file not found: %s", path)
+	}
+	return content, nil
+}
+
+var testFiles = mapReader{
+	"loader/loader.go": `package loader
+
+type service struct{}
+
+const defaultSize = 10
+
+var ErrNotFound = errors.New("not found")
+
+func (s *service) UpdateDocuments() error { return nil }
+
+func NewService() *service { return &service{} }
+`,
+	"cmd/load/cmd.go": `package load
+
+func runLoad() {
+	svc := loader.NewService()
+	svc.UpdateDocuments()
+}
+`,
+	"main.tf": `resource "google_storage_bucket" "example" {
+  name = "example"
+}
+
+resource "google_storage_bucket_iam_member" "member" {
+  bucket = google_storage_bucket.example.name
+}
+`,
+}
+
+func TestBuildIndex(t *testing.T) {
+	idx := BuildIndex([]string{"loader/loader.go", "cmd/load/cmd.go", "main.tf", "README.md", "missing.go"}, testFiles)
+
+	tests := []struct {
+		name string
+		kind Kind
+		path string
+	}{
+		{"service", KindType, "loader/loader.go"},
+		{"defaultSize", KindConst, "loader/loader.go"},
+		{"ErrNotFound", KindVar, "loader/loader.go"},
+		{"service.UpdateDocuments", KindMethod, "loader/loader.go"},
+		{"UpdateDocuments", KindMethod, "loader/loader.go"},
+		{"NewService", KindFunc, "loader/loader.go"},
+		{"google_storage_bucket.example", KindHCLBlock, "main.tf"},
+		{"example", KindHCLBlock, "main.tf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defs := idx.Definitions(tt.name)
+			if assert.Len(t, defs, 1) {
+				assert.Equal(t, tt.kind, defs[0].Kind)
+				assert.Equal(t, tt.path, defs[0].Path)
+			}
+		})
+	}
+
+	assert.Equal(t, []string{"cmd/load/cmd.go", "loader/loader.go"}, idx.References("service.UpdateDocuments"))
+	assert.Equal(t, []string{"main.tf"}, idx.References("google_storage_bucket.example"))
+	assert.Equal(t, []string{"service.UpdateDocuments"}, names(idx.Methods("UpdateDocuments")))
+	assert.Empty(t, idx.Methods("NewService"))
+	assert.Equal(t, []string{"service", "defaultSize", "ErrNotFound", "UpdateDocuments", "service.UpdateDocuments", "NewService"}, names(idx.Symbols("loader/loader.go")))
+}
+
+func names(defs []Definition) []string {
+	var res []string
+	for _, d := range defs {
+		res = append(res, d.Name)
+	}
+	return res
+}
+
+func TestExtractIdentifiers(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"Update UpdateDocuments to return ReviewResult", []string{"UpdateDocuments", "ReviewResult"}},
+		{"rename `run` to runPlan", []string{"run", "runPlan"}},
+		{"use vectorstore.VectorStore and max_attempts", []string{"vectorstore.VectorStore", "max_attempts"}},
+		{"make review output nicer", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExtractIdentifiers(tt.query))
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package tool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/git"
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/retriever"
+	"github.com/nakamasato/aicoder/internal/symbol"
+)
+
+const (
+	defaultMaxResults = 10
+	defaultMaxMatches = 50
+	defaultMaxLines   = 200
+)
+
+// Tool is a function that the LLM can call with GenerateFunctionCalling.
+type Tool interface {
+	// Definition is the name, description and parameters of the tool passed to the LLM.
+	Definition() llm.Tool
+	// Call executes the tool with the arguments generated by the LLM and returns the result passed back to the LLM.
+	Call(ctx context.Context, args map[string]interface{}) (string, error)
+}
+
+// Definitions returns the definitions of the tools.
+func Definitions(tools []Tool) []llm.Tool {
+	defs := make([]llm.Tool, len(tools))
+	for i, t := range tools {
+		defs[i] = t.Definition()
+	}
+	return defs
+}
+
+// Call calls the tool with the name of the tool call.
+// Errors are returned as the result so that the LLM can retry with other arguments.
+func Call(ctx context.Context, tools []Tool, call llm.ToolCall) string {
+	for _, t := range tools {
+		if t.Definition().Name != call.FunctionName {
+			continue
+		}
+		result, err := t.Call(ctx, call.Arguments)
+		if err != nil {
+			return fmt.Sprintf("Error: %v", err)
+		}
+		return result
+	}
+	return fmt.Sprintf("Error: unknown tool %s", call.FunctionName)
+}
+
+// FormatCall formats the tool call. e.g. grep({"pattern":"NewClient"})
+func FormatCall(call llm.ToolCall) string {
+	args, err := json.Marshal(call.Arguments)
+	if err != nil {
+		args = []byte(fmt.Sprint(call.Arguments))
+	}
+	return fmt.Sprintf("%s(%s)", call.FunctionName, args)
+}
+
+type searchTool struct {
+	retriever  retriever.Retriever
+	maxResults int
+}
+
+// NewSearch creates the tool to search for the files relevant to a natural language query with the retriever (e.g. the vectorstore retriever).
+func NewSearch(r retriever.Retriever) Tool {
+	return &searchTool{retriever: r, maxResults: defaultMaxResults}
+}
+
+func (s *searchTool) Definition() llm.Tool {
+	return llm.Tool{
+		Name:        "search_files",
+		Description: "Search for the files relevant to the query by the semantic similarity of the file summaries. Returns the paths of the files.",
+		Properties: map[string]interface{}{
+			"query": map[string]string{"type": "string", "description": "Natural language description of what to look for. e.g. where the review result is saved"},
+		},
+		RequiredProperties: []string{"query"},
+	}
+}
+
+func (s *searchTool) Call(ctx context.Context, args map[string]interface{}) (string, error) {
+	query, err := stringArg(args, "query", true)
+	if err != nil {
+		return "", err
+	}
+	results, err := s.retriever.Retrieve(ctx, query)
+	if err != nil && !retriever.IsPartial(err) {
+		return "", fmt.Errorf("failed to search files: %w", err)
+	}
+	if len(results) == 0 {
+		return "No files found.", nil
+	}
+	var b strings.Builder
+	for i, r := range results {
+		if i >= s.maxResults {
+			break
+		}
+		b.WriteString(r.File.Path + "\n")
+	}
+	return b.String(), nil
+}
+
+type grepTool struct {
+	repoPath   string
+	maxMatches int
+}
+
+// NewGrep creates the tool to search for a regular expression in the files committed in the git repository at repoPath.
+func NewGrep(repoPath string) Tool {
+	return &grepTool{repoPath: repoPath, maxMatches: defaultMaxMatches}
+}
+
+func (g *grepTool) Definition() llm.Tool {
+	return llm.Tool{
+		Name:        "grep",
+		Description: "Search for the lines matching the regular expression (Go syntax) in the files of the git repository. Returns the matching lines as path:line:content.",
+		Properties: map[string]interface{}{
+			"pattern": map[string]string{"type": "string", "description": "Regular expression to search for. e.g. func NewPlanner\\("},
+			"path":    map[string]string{"type": "string", "description": "Only search the files under this path. Empty to search all the files."},
+		},
+		RequiredProperties: []string{"pattern", "path"},
+	}
+}
+
+func (g *grepTool) Call(ctx context.Context, args map[string]interface{}) (string, error) {
+	pattern, err := stringArg(args, "pattern", true)
+	if err != nil {
+		return "", err
+	}
+	path, err := stringArg(args, "path", false)
+	if err != nil {
+		return "", err
+	}
+	matches, err := git.Grep(g.repoPath, pattern, strings.TrimPrefix(path, "./"))
+	if err != nil {
+		return "", err
+	}
+	if len(matches) == 0 {
+		return "No matches found.", nil
+	}
+	var b strings.Builder
+	for i, m := range matches {
+		if i >= g.maxMatches {
+			b.WriteString(fmt.Sprintf("... %d more matches. Please narrow down the pattern or the path.\n", len(matches)-g.maxMatches))
+			break
+		}
+		b.WriteString(m.String() + "\n")
+	}
+	return b.String(), nil
+}
+
+type readFileTool struct {
+	reader   file.FileReader
+	paths    map[string]bool
+	maxLines int
+}
+
+// NewReadFile creates the tool to read a range of lines of a file.
+// Only the files in paths (e.g. the files in the git tree of the repository structure) can be read
+// so that the files outside of the repository are not passed to the LLM.
+func NewReadFile(reader file.FileReader, paths []string) Tool {
+	r := &readFileTool{reader: reader, paths: make(map[string]bool, len(paths)), maxLines: defaultMaxLines}
+	for _, path := range paths {
+		r.paths[filepath.Clean(path)] = true
+	}
+	return r
+}
+
+func (r *readFileTool) Definition() llm.Tool {
+	return llm.Tool{
+		Name:        "read_file",
+		Description: fmt.Sprintf("Read the lines of the file from start_line to end_line (1-based, inclusive) with the line numbers. At most %d lines are returned at once.", r.maxLines),
+		Properties: map[string]interface{}{
+			"path":       map[string]string{"type": "string", "description": "Path to the file"},
+			"start_line": map[string]string{"type": "integer", "description": "First line to read. 1 to read from the beginning."},
+			"end_line":   map[string]string{"type": "integer", "description": "Last line to read. 0 to read to the end."},
+		},
+		RequiredProperties: []string{"path", "start_line", "end_line"},
+	}
+}
+
+func (r *readFileTool) Call(ctx context.Context, args map[string]interface{}) (string, error) {
+	path, err := stringArg(args, "path", true)
+	if err != nil {
+		return "", err
+	}
+	start, err := intArg(args, "start_line")
+	if err != nil {
+		return "", err
+	}
+	end, err := intArg(args, "end_line")
+	if err != nil {
+		return "", err
+	}
+	path, err = file.CleanPath(path)
+	if err != nil {
+		return "", err
+	}
+	if !r.paths[path] {
+		return "", fmt.Errorf("%s is not a file in the repository", path)
+	}
+	content, err := r.reader.ReadContent(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	start = max(start, 1)
+	if end <= 0 || end > len(lines) {
+		end = len(lines)
+	}
+	if start > end {
+		return "", fmt.Errorf("%s has %d lines: start_line %d is out of range", path, len(lines), start)
+	}
+	truncated := end-start+1 > r.maxLines
+	if truncated {
+		end = start + r.maxLines - 1
+	}
+	var b strings.Builder
+	for i := start; i <= end; i++ {
+		b.WriteString(fmt.Sprintf("%d: %s\n", i, lines[i-1]))
+	}
+	if truncated {
+		b.WriteString(fmt.Sprintf("... %d lines in total. Read from line %d to continue.\n", len(lines), end+1))
+	}
+	return b.String(), nil
+}
+
+type listSymbolsTool struct {
+	index *symbol.Index
+}
+
+// NewListSymbols creates the tool to list the symbols defined in a file or to find the definitions and the references of a symbol.
+func NewListSymbols(index *symbol.Index) Tool {
+	return &listSymbolsTool{index: index}
+}
+
+func (l *listSymbolsTool) Definition() llm.Tool {
+	return llm.Tool{
+		Name:        "list_symbols",
+		Description: "List the symbols (functions, methods, types, variables, constants and HCL blocks) defined in the file, or find where the symbol is defined and which files reference it. Set either path or name.",
+		Properties: map[string]interface{}{
+			"path": map[string]string{"type": "string", "description": "Path to the file to list the symbols of. Empty when name is set."},
+			"name": map[string]string{"type": "string", "description": "Name of the symbol to find. e.g. ReviewChanges, Planner.GeneratePlan. Empty when path is set."},
+		},
+		RequiredProperties: []string{"path", "name"},
+	}
+}
+
+func (l *listSymbolsTool) Call(ctx context.Context, args map[string]interface{}) (string, error) {
+	path, err := stringArg(args, "path", false)
+	if err != nil {
+		return "", err
+	}
+	name, err := stringArg(args, "name", false)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	switch {
+	case path != "":
+		defs := l.index.Symbols(strings.TrimPrefix(path, "./"))
+		if len(defs) == 0 {
+			return fmt.Sprintf("No symbols found in %s.", path), nil
+		}
+		for _, d := range defs {
+			b.WriteString(fmt.Sprintf("%s:%d: %s %s\n", d.Path, d.Line, d.Kind, d.Name))
+		}
+	case name != "":
+		defs := l.index.Definitions(name)
+		if len(defs) == 0 {
+			return fmt.Sprintf("No definitions of %s found.", name), nil
+		}
+		b.WriteString("Definitions:\n")
+		for _, d := range defs {
+			b.WriteString(fmt.Sprintf("- %s:%d: %s %s\n", d.Path, d.Line, d.Kind, d.Name))
+		}
+		b.WriteString("Referenced in:\n")
+		for _, p := range l.index.References(name) {
+			b.WriteString(fmt.Sprintf("- %s\n", p))
+		}
+	default:
+		return "", fmt.Errorf("either path or name is required")
+	}
+	return b.String(), nil
+}
+
+func stringArg(args map[string]interface{}, name string, required bool) (string, error) {
+	v, ok := args[name]
+	if !ok || v == nil {
+		if required {
+			return "", fmt.Errorf("%s is required", name)
+		}
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string: %v", name, v)
+	}
+	if required && s == "" {
+		return "", fmt.Errorf("%s is required", name)
+	}
+	return s, nil
+}
+
+// intArg returns the integer argument. JSON numbers are decoded as float64. Missing arguments are 0.
+func intArg(args map[string]interface{}, name string) (int, error) {
+	v, ok := args[name]
+	if !ok || v == nil {
+		return 0, nil
+	}
+	n, ok := v.(float64)
+	if !ok {
+		return 0, fmt.Errorf("%s must be an integer: %v", name, v)
+	}
+	return int(n), nil
+}
@@ -0,0 +1,132 @@
+package tool
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/llm"
+	"github.com/nakamasato/aicoder/internal/retriever"
+	"github.com/nakamasato/aicoder/internal/symbol"
+	"github.com/stretchr/testify/assert"
+)
+
+type staticRetriever struct {
+	paths []string
+}
+
+func (s staticRetriever) Name() string { return "static" }
+
+func (s staticRetriever) Retrieve(ctx context.Context, query string) ([]retriever.Result, error) {
+	results := make([]retriever.Result, len(s.paths))
+	for i, p := range s.paths {
+		results[i] = retriever.Result{File: file.File{Path: p}}
+	}
+	return results, nil
+}
+
+const content = `package main
+
+// Client is a client.
+type Client struct{}
+
+func NewClient() *Client {
+	return &Client{}
+}
+`
+
+func TestSearch(t *testing.T) {
+	s := NewSearch(staticRetriever{paths: []string{"a.go", "b.go"}})
+	got, err := s.Call(context.Background(), map[string]interface{}{"query": "client"})
+	assert.NoError(t, err)
+	assert.Equal(t, "a.go\nb.go\n", got)
+
+	_, err = s.Call(context.Background(), map[string]interface{}{})
+	assert.ErrorContains(t, err, "query is required")
+}
+
+func TestGrep(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	assert.NoError(t, err)
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(content), 0644))
+	_, err = wt.Add("main.go")
+	assert.NoError(t, err)
+	_, err = wt.Commit("init", &git.CommitOptions{Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()}})
+	assert.NoError(t, err)
+
+	g := NewGrep(dir)
+	got, err := g.Call(context.Background(), map[string]interface{}{"pattern": `func New\w+`, "path": ""})
+	assert.NoError(t, err)
+	assert.Equal(t, "main.go:6:func NewClient() *Client {\n", got)
+
+	got, err = g.Call(context.Background(), map[string]interface{}{"pattern": "Client", "path": "cmd/"})
+	assert.NoError(t, err)
+	assert.Equal(t, "No matches found.", got)
+
+	g.(*grepTool).maxMatches = 1
+	got, err = g.Call(context.Background(), map[string]interface{}{"pattern": "Client", "path": "./"})
+	assert.NoError(t, err)
+	assert.Equal(t, "main.go:3:// Client is a client.\n... 3 more matches. Please narrow down the pattern or the path.\n", got)
+}
+
+func TestReadFile(t *testing.T) {
+	r := NewReadFile(file.MockFileReader{Content: content}, []string{"main.go"})
+	got, err := r.Call(context.Background(), map[string]interface{}{"path": "main.go", "start_line": float64(6), "end_line": float64(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, "6: func NewClient() *Client {\n7: \treturn &Client{}\n8: }\n", got)
+
+	r.(*readFileTool).maxLines = 2
+	got, err = r.Call(context.Background(), map[string]interface{}{"path": "main.go", "start_line": float64(0), "end_line": float64(4)})
+	assert.NoError(t, err)
+	assert.Equal(t, "1: package main\n2: \n... 8 lines in total. Read from line 3 to continue.\n", got)
+
+	_, err = r.Call(context.Background(), map[string]interface{}{"path": "main.go", "start_line": float64(9), "end_line": float64(0)})
+	assert.ErrorContains(t, err, "out of range")
+	_, err = r.Call(context.Background(), map[string]interface{}{"path": "main.go", "start_line": "1"})
+	assert.ErrorContains(t, err, "start_line must be an integer")
+
+	// the files outside of the repository are not read
+	got, err = r.Call(context.Background(), map[string]interface{}{"path": "./cmd/../main.go", "start_line": float64(1), "end_line": float64(1)})
+	assert.NoError(t, err)
+	assert.Equal(t, "1: package main\n", got)
+	_, err = r.Call(context.Background(), map[string]interface{}{"path": "/etc/passwd", "start_line": float64(1), "end_line": float64(0)})
+	assert.ErrorContains(t, err, "absolute path is not allowed")
+	_, err = r.Call(context.Background(), map[string]interface{}{"path": "../main.go", "start_line": float64(1), "end_line": float64(0)})
+	assert.ErrorContains(t, err, "path outside of the repository is not allowed")
+	_, err = r.Call(context.Background(), map[string]interface{}{"path": ".env", "start_line": float64(1), "end_line": float64(0)})
+	assert.ErrorContains(t, err, ".env is not a file in the repository")
+}
+
+func TestListSymbols(t *testing.T) {
+	l := NewListSymbols(symbol.BuildIndex([]string{"main.go"}, file.MockFileReader{Content: content}))
+	got, err := l.Call(context.Background(), map[string]interface{}{"path": "main.go", "name": ""})
+	assert.NoError(t, err)
+	assert.Equal(t, "main.go:4: type Client\nmain.go:6: func NewClient\n", got)
+
+	got, err = l.Call(context.Background(), map[string]interface{}{"path": "", "name": "Client"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Definitions:\n- main.go:4: type Client\nReferenced in:\n- main.go\n", got)
+
+	_, err = l.Call(context.Background(), map[string]interface{}{})
+	assert.ErrorContains(t, err, "either path or name is required")
+}
+
+func TestCall(t *testing.T) {
+	tools := []Tool{NewReadFile(file.MockFileReader{Content: content}, []string{"main.go"})}
+	assert.Equal(t, "read_file", Definitions(tools)[0].Name)
+
+	call := llm.ToolCall{FunctionName: "read_file", Arguments: map[string]interface{}{"path": "main.go", "start_line": float64(1), "end_line": float64(1)}}
+	assert.Equal(t, "1: package main\n", Call(context.Background(), tools, call))
+	assert.Equal(t, `read_file({"end_line":1,"path":"main.go","start_line":1})`, FormatCall(call))
+
+	assert.Equal(t, "Error: path is required", Call(context.Background(), tools, llm.ToolCall{FunctionName: "read_file"}))
+	assert.Equal(t, "Error: unknown tool grep", Call(context.Background(), tools, llm.ToolCall{FunctionName: "grep"}))
+}
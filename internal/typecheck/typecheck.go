@@ -0,0 +1,188 @@
+package typecheck
+
+import (
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/nakamasato/aicoder/internal/applier"
+	"github.com/nakamasato/aicoder/internal/file"
+	"github.com/nakamasato/aicoder/internal/planner"
+	"golang.org/x/tools/go/packages"
+)
+
+// Checker type-checks the Go changes by applying them in memory and loading the changed packages
+// (including their tests) with the changed files overlaid on the files on disk.
+type Checker struct{}
+
+// NewChecker creates the Checker. It implements planner.TypeChecker.
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// lineRange is the lines of the new content of a change in the changed file.
+type lineRange struct {
+	index      int
+	start, end int
+}
+
+// Check type-checks the packages changed by the changes and returns the compile errors in the new contents of the changes.
+// The changes that cannot be applied to the files are reported with the errors instead. The rejected changes are ignored.
+// The errors outside of the new contents (e.g. in the callers of a deleted function) are not reported.
+func (c *Checker) Check(ctx context.Context, changes []planner.BlockChange) ([]planner.TypeError, error) {
+	var goChanges []planner.BlockChange
+	var indices []int
+	for i, change := range changes {
+		if filepath.Ext(change.Block.Path) == ".go" && change.Decision != planner.DecisionRejected {
+			goChanges = append(goChanges, change)
+			indices = append(indices, i)
+		}
+	}
+	if len(goChanges) == 0 {
+		return nil, nil
+	}
+
+	contents, errs := applier.PreviewChanges(goChanges)
+	var typeErrors []planner.TypeError
+	for i, err := range errs {
+		typeErrors = append(typeErrors, planner.TypeError{Index: indices[i], Message: fmt.Sprintf("the change cannot be applied: %v", err)})
+	}
+
+	overlay := map[string][]byte{}
+	ranges := map[string][]lineRange{} // absolute path -> lines of the new contents
+	dirs := map[string]bool{}
+	for path, content := range contents {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute path of %s: %w", path, err)
+		}
+		if content == nil && !file.Exists(path) {
+			continue // added and deleted by the changes
+		}
+		if content == nil {
+			// a deleted file is replaced with the package clause so that its declarations are removed from the package
+			content, err = packageClause(path)
+			if err != nil {
+				return nil, err
+			}
+		}
+		overlay[absPath] = content
+		if file.Exists(filepath.Dir(absPath)) {
+			// the packages in new directories are not type-checked
+			dirs[filepath.Dir(absPath)] = true
+		}
+	}
+	for i, change := range goChanges {
+		content := contents[change.Block.Path]
+		if content == nil {
+			continue
+		}
+		absPath, err := filepath.Abs(change.Block.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute path of %s: %w", change.Block.Path, err)
+		}
+		for _, r := range changedLines(change, content) {
+			r.index = indices[i]
+			ranges[absPath] = append(ranges[absPath], r)
+		}
+	}
+
+	patterns := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		patterns = append(patterns, dir)
+	}
+	if len(patterns) == 0 {
+		return sortByIndex(typeErrors), nil
+	}
+	sort.Strings(patterns)
+	pkgs, err := packages.Load(&packages.Config{
+		Context: ctx,
+		Dir:     patterns[0], // the go command runs in the module of the changed files
+		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedTypes | packages.NeedSyntax,
+		Tests:   true,
+		Overlay: overlay,
+	}, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+
+	// the files of a package are type-checked in both the package and its test variant
+	seen := map[planner.TypeError]bool{}
+	for _, pkg := range pkgs {
+		for _, e := range pkg.TypeErrors {
+			pos := e.Fset.Position(e.Pos)
+			for _, r := range ranges[pos.Filename] {
+				if pos.Line < r.start || pos.Line > r.end {
+					continue
+				}
+				typeError := planner.TypeError{Index: r.index, Message: fmt.Sprintf("%s:%d:%d: %s", changes[r.index].Block.Path, pos.Line, pos.Column, e.Msg)}
+				if !seen[typeError] {
+					seen[typeError] = true
+					typeErrors = append(typeErrors, typeError)
+				}
+			}
+		}
+	}
+	return sortByIndex(typeErrors), nil
+}
+
+// sortByIndex sorts the type errors by the index of the change keeping the order of the errors of each change.
+func sortByIndex(typeErrors []planner.TypeError) []planner.TypeError {
+	sort.SliceStable(typeErrors, func(i, j int) bool { return typeErrors[i].Index < typeErrors[j].Index })
+	return typeErrors
+}
+
+// changedLines returns the lines of the new content of the change in the changed file.
+// The blocks added or updated by the change are looked up by their names.
+func changedLines(change planner.BlockChange, content []byte) []lineRange {
+	if change.Block.TargetType == "file" {
+		return []lineRange{{start: 1, end: strings.Count(string(content), "\n") + 1}}
+	}
+	var names []string
+	switch change.GetAction() {
+	case planner.ActionTypeAdd:
+		blocks, err := file.ParseGoBlocksFromSource([]byte("package p\n" + change.NewContent))
+		if err != nil {
+			return nil
+		}
+		for _, b := range blocks {
+			names = append(names, b.Name)
+		}
+	case planner.ActionTypeUpdate:
+		names = append(names, change.Block.TargetName)
+	default:
+		return nil
+	}
+
+	blocks, err := file.ParseGoBlocksFromSource(content)
+	if err != nil {
+		return nil
+	}
+	var ranges []lineRange
+	for _, b := range blocks {
+		for _, name := range names {
+			if b.Name == name {
+				ranges = append(ranges, lineRange{start: b.StartLine, end: b.EndLine})
+			}
+		}
+	}
+	return ranges
+}
+
+// packageClause returns the package clause of the Go file on disk.
+func packageClause(path string) ([]byte, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file (%s): %w", path, err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), path, src, parser.PackageClauseOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package clause of %s: %w", path, err)
+	}
+	return []byte(fmt.Sprintf("package %s\n", f.Name.Name)), nil
+}
@@ -0,0 +1,123 @@
+package typecheck
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nakamasato/aicoder/internal/planner"
+	"github.com/stretchr/testify/assert"
+)
+
+func setUpModule(t *testing.T) string {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/sample\n\ngo 1.21\n",
+		"main.go": `package main
+
+func main() {
+	println(greet("world"))
+}
+
+func greet(name string) string {
+	return "Hello, " + name
+}
+`,
+		"main_test.go": `package main
+
+import "testing"
+
+func TestGreet(t *testing.T) {
+	if greet("a") != "Hello, a" {
+		t.Fail()
+	}
+}
+`,
+		"README.md": "# Sample\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create file: %v", err)
+		}
+	}
+	return dir
+}
+
+func TestCheck(t *testing.T) {
+	dir := setUpModule(t)
+	mainFile, testFile := filepath.Join(dir, "main.go"), filepath.Join(dir, "main_test.go")
+	greet := planner.Block{Path: mainFile, TargetType: "function", TargetName: "greet"}
+
+	tests := []struct {
+		name    string
+		changes []planner.BlockChange
+		want    []planner.TypeError
+	}{
+		{
+			name: "valid changes",
+			changes: []planner.BlockChange{
+				{Block: greet, NewContent: `return "Hi, " + name`},
+				{Action: planner.ActionTypeAdd, Block: planner.Block{Path: mainFile, TargetType: "function", TargetName: "bye"}, NewContent: "func bye() string {\n\treturn greet(\"bye\")\n}"},
+			},
+		},
+		{
+			name: "undefined identifier in the updated function",
+			changes: []planner.BlockChange{
+				{Block: planner.Block{Path: filepath.Join(dir, "README.md"), TargetType: "file"}, NewContent: "# New\n"},
+				{Block: greet, NewContent: "return prefix + name"},
+			},
+			want: []planner.TypeError{{Index: 1, Message: mainFile + ":8:9: undefined: prefix"}},
+		},
+		{
+			name: "wrong type in the added function and the test file",
+			changes: []planner.BlockChange{
+				{Action: planner.ActionTypeAdd, Block: planner.Block{Path: mainFile, TargetType: "function", TargetName: "count"}, NewContent: "func count() int {\n\treturn greet(\"a\")\n}"},
+				{Block: planner.Block{Path: testFile, TargetType: "function", TargetName: "TestGreet"}, NewContent: "if greet(1) != \"Hello, a\" {\n\tt.Fail()\n}"},
+			},
+			want: []planner.TypeError{
+				{Index: 0, Message: mainFile + ":12:9: cannot use greet(\"a\") (value of type string) as int value in return statement"},
+				{Index: 1, Message: testFile + ":6:11: cannot use 1 (untyped int constant) as string value in argument to greet"},
+			},
+		},
+		{
+			name: "the errors outside of the changes are not reported",
+			changes: []planner.BlockChange{
+				{Action: planner.ActionTypeDelete, Block: greet},
+			},
+		},
+		{
+			name: "the changes that cannot be applied",
+			changes: []planner.BlockChange{
+				{Block: greet, NewContent: `return "Hi, " + name`},
+				{Block: planner.Block{Path: mainFile, TargetType: "function", TargetName: "unknown"}, NewContent: "return"},
+			},
+			want: []planner.TypeError{{Index: 1, Message: "the change cannot be applied: failed to apply change to go file (" + mainFile + "): function unknown not found"}},
+		},
+		{
+			name: "rejected changes are ignored",
+			changes: []planner.BlockChange{
+				{Block: greet, NewContent: "return prefix + name", Decision: planner.DecisionRejected},
+			},
+		},
+		{
+			name: "entire file",
+			changes: []planner.BlockChange{
+				{Block: planner.Block{Path: mainFile, TargetType: "file", TargetName: mainFile}, NewContent: "package main\n\nfunc main() {}\n\nfunc greet(name string) string {\n\treturn name + 1\n}\n"},
+			},
+			want: []planner.TypeError{{Index: 0, Message: mainFile + ":6:9: invalid operation: name + 1 (mismatched types string and untyped int)"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewChecker().Check(context.Background(), tt.changes)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// the files are not changed
+	content, err := os.ReadFile(mainFile)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), `return "Hello, " + name`)
+}
@@ -17,9 +17,16 @@ import (
 
 type VectorStore interface {
 	AddDocument(ctx context.Context, doc *Document) error
+	AddDocuments(ctx context.Context, docs []*Document) error
 	Search(ctx context.Context, repository, context, query string, k int) (*SearchResult, error)
+	// SearchMany searches for each query. The queries are embedded in a single embedding request
+	// and the vectorstore is searched once per query.
+	SearchMany(ctx context.Context, repository, context string, queries []string, k int) ([]*SearchResult, error)
 }
 
+// DefaultBatchSize is the number of documents embedded and upserted in a single round trip by AddDocuments.
+const DefaultBatchSize = 500
+
 type DistanceFunc func(a, b []float32) float64
 
 func EuclideanDistance(a, b []float32) float64 {
@@ -37,6 +44,26 @@ func EuclideanDistance(a, b []float32) float64 {
 	return math.Sqrt(sum)
 }
 
+// CosineSimilarity returns the cosine similarity of the two vectors.
+// It returns 0 if the vectors have different lengths or either of them is a zero vector.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := 0; i < len(a); i++ {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
 type vectorstore struct {
 	llmClient    llm.Client
 	entClient    *ent.Client
@@ -48,15 +75,18 @@ type Document struct {
 	Context     string
 	Filepath    string
 	Description string
+	BlobHash    string
 }
 
 type DocumentWithScore struct {
-	Document *Document
-	Score    float64
+	Document  *Document
+	Score     float64
+	Embedding []float32
 }
 
 type SearchResult struct {
-	Documents *[]DocumentWithScore
+	Documents      *[]DocumentWithScore
+	QueryEmbedding []float32
 }
 
 func (r *SearchResult) String() string {
@@ -82,6 +112,8 @@ func (c *vectorstore) AddDocument(ctx context.Context, doc *Document) error {
 		SetRepository(doc.Repository).
 		SetContext(doc.Context).
 		SetDescription(doc.Description).
+		SetBlobHash(doc.BlobHash).
+		SetEmbeddingModel(c.llmClient.EmbeddingModel()).
 		SetEmbedding(vector).
 		SetUpdatedAt(time.Now()).
 		OnConflictColumns(document.FieldRepository, document.FieldContext, document.FieldFilepath).
@@ -90,11 +122,74 @@ func (c *vectorstore) AddDocument(ctx context.Context, doc *Document) error {
 	return err
 }
 
+// AddDocuments adds the documents in batches.
+// Each batch takes one embedding request and one bulk upsert.
+func (c *vectorstore) AddDocuments(ctx context.Context, docs []*Document) error {
+	for start := 0; start < len(docs); start += DefaultBatchSize {
+		end := min(start+DefaultBatchSize, len(docs))
+		if err := c.addDocumentBatch(ctx, docs[start:end]); err != nil {
+			return fmt.Errorf("failed to add documents [%d:%d]: %w", start, end, err)
+		}
+	}
+	return nil
+}
+
+func (c *vectorstore) addDocumentBatch(ctx context.Context, docs []*Document) error {
+	descriptions := make([]string, len(docs))
+	for i, doc := range docs {
+		descriptions[i] = doc.Description
+	}
+	embeddings, err := c.llmClient.GetEmbeddings(ctx, descriptions)
+	if err != nil {
+		return err
+	}
+	if len(embeddings) != len(docs) {
+		return fmt.Errorf("got %d embeddings for %d documents", len(embeddings), len(docs))
+	}
+
+	now := time.Now()
+	builders := make([]*ent.DocumentCreate, len(docs))
+	for i, doc := range docs {
+		builders[i] = c.entClient.Document.Create().
+			SetFilepath(doc.Filepath).
+			SetRepository(doc.Repository).
+			SetContext(doc.Context).
+			SetDescription(doc.Description).
+			SetBlobHash(doc.BlobHash).
+			SetEmbeddingModel(c.llmClient.EmbeddingModel()).
+			SetEmbedding(pgvector.NewVector(embeddings[i])).
+			SetUpdatedAt(now)
+	}
+	return c.entClient.Document.CreateBulk(builders...).
+		OnConflictColumns(document.FieldRepository, document.FieldContext, document.FieldFilepath).
+		UpdateNewValues().
+		Exec(ctx)
+}
+
 func (c *vectorstore) Search(ctx context.Context, repository, context, query string, k int) (*SearchResult, error) {
 	queryEmbedding, err := c.llmClient.GetEmbedding(ctx, query)
 	if err != nil {
 		return nil, err
 	}
+	return c.searchByEmbedding(ctx, repository, context, queryEmbedding, k)
+}
+
+// SearchMany embeds all the queries in one request and runs one similarity search per query embedding.
+func (c *vectorstore) SearchMany(ctx context.Context, repository, context string, queries []string, k int) ([]*SearchResult, error) {
+	embeddings, err := c.llmClient.GetEmbeddings(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*SearchResult, len(embeddings))
+	for i, embedding := range embeddings {
+		if results[i], err = c.searchByEmbedding(ctx, repository, context, embedding, k); err != nil {
+			return nil, fmt.Errorf("failed to search for %q: %w", queries[i], err)
+		}
+	}
+	return results, nil
+}
+
+func (c *vectorstore) searchByEmbedding(ctx context.Context, repository, context string, queryEmbedding []float32, k int) (*SearchResult, error) {
 	vector := pgvector.NewVector(queryEmbedding)
 
 	docs, err := c.entClient.Document.
@@ -107,7 +202,7 @@ func (c *vectorstore) Search(ctx context.Context, repository, context, query str
 	if err != nil {
 		return nil, err
 	}
-	results := SearchResult{Documents: &[]DocumentWithScore{}}
+	results := SearchResult{Documents: &[]DocumentWithScore{}, QueryEmbedding: queryEmbedding}
 	for _, doc := range docs {
 		distance := c.distanceFunc(doc.Embedding.Slice(), queryEmbedding)
 		*results.Documents = append(*results.Documents, DocumentWithScore{
@@ -116,8 +211,10 @@ func (c *vectorstore) Search(ctx context.Context, repository, context, query str
 				Context:     doc.Context,
 				Filepath:    doc.Filepath,
 				Description: doc.Description,
+				BlobHash:    doc.BlobHash,
 			},
-			Score: distance,
+			Score:     distance,
+			Embedding: doc.Embedding.Slice(),
 		})
 	}
 	return &results, nil
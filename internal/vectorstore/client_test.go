@@ -60,6 +60,55 @@ func TestVectorStore_AddDocument(t *testing.T) {
 	}
 }
 
+func TestVectorStore_AddDocuments(t *testing.T) {
+	ctx := context.Background()
+
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Fatal("TEST_DATABASE_URL is not set")
+	}
+
+	entClient, err := ent.Open("postgres", dbURL)
+	if err != nil {
+		t.Fatalf("failed opening connection to postgres: %v", err)
+	}
+	defer entClient.Close()
+	vectorstoreClient := New(entClient, llm.DummyClient{})
+
+	docs := []*Document{
+		{Repository: "test-repository-bulk", Context: "default", Filepath: "test/file1.go", Description: "description 1"},
+		{Repository: "test-repository-bulk", Context: "default", Filepath: "test/file2.go", Description: "description 2"},
+	}
+	if err := vectorstoreClient.AddDocuments(ctx, docs); err != nil {
+		t.Fatalf("failed to add documents: %v", err)
+	}
+
+	// Upsert the same documents with a new description
+	docs[0].Description = "updated description 1"
+	if err := vectorstoreClient.AddDocuments(ctx, docs); err != nil {
+		t.Fatalf("failed to upsert documents: %v", err)
+	}
+
+	stored, err := entClient.Document.Query().Where(document.RepositoryEQ("test-repository-bulk")).All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query documents: %v", err)
+	}
+	if len(stored) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(stored))
+	}
+	for _, doc := range stored {
+		if doc.Filepath == "test/file1.go" && doc.Description != "updated description 1" {
+			t.Fatalf("expected description to be updated, got '%s'", doc.Description)
+		}
+	}
+
+	// Clean up
+	_, err = entClient.Document.Delete().Where(document.RepositoryEQ("test-repository-bulk")).Exec(ctx)
+	if err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+}
+
 func TestEuclideanDistance(t *testing.T) {
 	// aicoder=# select embedding <-> '[1,2,3]' from pg_test ;
 	//  ?column?